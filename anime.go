@@ -1,6 +1,8 @@
 package myanimelist
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -14,17 +16,19 @@ type Anime struct {
 }
 
 // AnimeSearch return list of anime, performing search for similar as provided search string.
-func (a *Anime) Search(search string, settings PagingSettings) (*AnimeSearchResult, error) {
-	method := http.MethodGet
+func (a *Anime) Search(search string, settings PagingSettings, fields ...Field) (*AnimeSearchResult, error) {
 	path := "./anime"
 
 	data := url.Values{
 		"q": {search},
 	}
+	if err := setFields(data, a.mal.contentPolicy.withFields(fields, "anime"), animeSchema, "anime"); err != nil {
+		return nil, err
+	}
 	settings.set(&data)
 
 	searchResult := &AnimeSearchResult{parent: a}
-	if err := a.mal.request(searchResult, method, path, data); err != nil {
+	if err := a.mal.requestPage(context.Background(), searchResult, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 	return searchResult, nil
@@ -33,58 +37,108 @@ func (a *Anime) Search(search string, settings PagingSettings) (*AnimeSearchResu
 // AnimeSearchResult stores array with search entries.
 // Use Prev() and Next() methods to retrieve corresponding result pages.
 type AnimeSearchResult struct {
+	listing
 	parent *Anime
-	Data   []struct {
-		Node `json:"node"`
-	} `json:"data"`
-	Paging Paging `json:"paging"`
+	Data   []AnimeEntry `json:"data"`
+	Paging Paging       `json:"paging"`
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *AnimeSearchResult) Prev(limit ...int) (result *AnimeSearchResult, err error) {
-	result = &AnimeSearchResult{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *AnimeSearchResult) Prev(limit ...int) (*AnimeSearchResult, error) {
+	return adjacentPage[*AnimeSearchResult, AnimeEntry](obj, -1, limit)
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *AnimeSearchResult) Next(limit ...int) (result *AnimeSearchResult, err error) {
-	result = &AnimeSearchResult{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *AnimeSearchResult) Next(limit ...int) (*AnimeSearchResult, error) {
+	return adjacentPage[*AnimeSearchResult, AnimeEntry](obj, 1, limit)
 }
 
-// AnimeDetails returns details about anime with provided ID.
-// You can control which fields to retrieve. For all fields use FieldAllAvailable.
-// With no fields provided api still returns ID, Title and MainPicture fields
-func (a *Anime) Details(animeID int, fields ...string) (*AnimeDetails, error) {
-	method := http.MethodGet
-	path := fmt.Sprintf("./anime/%d", animeID)
+// HasNext reports whether there is next result page.
+func (obj *AnimeSearchResult) HasNext() bool {
+	return obj.Paging.Next != ""
+}
 
-	var acceptableArr = append(generalFields, animeFields...)
-	acceptable := makeList(acceptableArr)
+// HasPrev reports whether there is previous result page.
+func (obj *AnimeSearchResult) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
 
-	if fields[0] == FieldAllAvailable {
-		fields = acceptableArr
-	}
+// Items returns entries of current page.
+func (obj *AnimeSearchResult) Items() []AnimeEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *AnimeSearchResult) Iter() *Iterator[AnimeEntry] {
+	return newIterator[AnimeEntry](obj)
+}
+
+func (obj *AnimeSearchResult) emptyPage() page[AnimeEntry] {
+	return &AnimeSearchResult{parent: obj.parent}
+}
+
+func (obj *AnimeSearchResult) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *AnimeSearchResult) client() *MAL {
+	return obj.parent.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *AnimeSearchResult) FetchAll(settings FetchAllSettings) ([]AnimeEntry, error) {
+	return fetchAll[AnimeEntry](context.Background(), obj, settings, func(entry AnimeEntry) int { return entry.ID })
+}
 
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *AnimeSearchResult) Stream(ctx context.Context) *Stream[AnimeEntry] {
+	return newStream[AnimeEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *AnimeSearchResult) Offset(k int) (*AnimeSearchResult, error) {
+	return offsetPage[*AnimeSearchResult, AnimeEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *AnimeSearchResult) First() (*AnimeSearchResult, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *AnimeSearchResult) Page(n int) (*AnimeSearchResult, error) {
+	return numberedPage[*AnimeSearchResult, AnimeEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *AnimeSearchResult) CurrentPage() int {
+	return currentPage[AnimeEntry](obj)
+}
+
+// AnimeDetails returns details about anime with provided ID.
+// You can control which fields to retrieve. For all fields use FieldAllAvailable.
+// Fields can have sub-fields (see Field.With()), unknown fields are reported with error.
+// With no fields provided api still returns ID, Title and MainPicture fields
+func (a *Anime) Details(animeID int, fields ...Field) (*AnimeDetails, error) {
 	data := url.Values{}
-	fieldsString := ""
-	for k, f := range fields {
-		if _, ok := acceptable[f]; !ok {
-			continue
-		}
-		if k != 0 {
-			fieldsString += ", "
-		}
-		fieldsString += f
+	if err := setFields(data, fields, animeSchema, "anime"); err != nil {
+		return nil, err
 	}
-	data.Set("fields", fieldsString)
+	return a.details(context.Background(), animeID, data)
+}
+
+// details requests anime with already validated fields.
+func (a *Anime) details(ctx context.Context, animeID int, data url.Values) (*AnimeDetails, error) {
+	method := http.MethodGet
+	path := fmt.Sprintf("./anime/%d", animeID)
 
 	anime := &AnimeDetails{}
-	if err := a.mal.request(anime, method, path, data); err != nil {
+	if err := a.mal.requestContext(ctx, anime, method, path, data); err != nil {
 		return nil, err
 	}
 
@@ -93,71 +147,143 @@ func (a *Anime) Details(animeID int, fields ...string) (*AnimeDetails, error) {
 
 // AnimeDetails contain info about certain anime.
 type AnimeDetails struct {
-	ID                int     `json:"id"`
-	Title             string  `json:"title"`
-	MainPicture       Picture `json:"main_picture"`
-	AlternativeTitles struct {
-		Synonyms []string `json:"synonyms"`
-		En       string   `json:"en"`
-		Ja       string   `json:"ja"`
-	} `json:"alternative_titles"`
-	StartDate       string          `json:"start_date"`
-	EndDate         string          `json:"end_date"`
-	Synopsis        string          `json:"synopsis"`
-	Mean            float64         `json:"mean"`
-	Rank            int             `json:"rank"`
-	Popularity      int             `json:"popularity"`
-	NumListUsers    int             `json:"num_list_users"`
-	NumScoringUsers int             `json:"num_scoring_users"`
-	Nsfw            string          `json:"nsfw"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	MediaType       string          `json:"media_type"`
-	Status          string          `json:"status"`
-	Genres          []Genre         `json:"genres"`
-	MyListStatus    AnimeListStatus `json:"my_list_status"`
-	NumEpisodes     int             `json:"num_episodes"`
-	StartSeason     struct {
-		Year   int    `json:"year"`
-		Season string `json:"season"`
-	} `json:"start_season"`
-	Broadcast struct {
-		DayOfTheWeek string `json:"day_of_the_week"`
-		StartTime    string `json:"start_time"`
-	} `json:"broadcast"`
-	Source                 string    `json:"source"`
-	AverageEpisodeDuration int       `json:"average_episode_duration"`
-	Rating                 string    `json:"rating"`
-	Pictures               []Picture `json:"pictures"`
-	Background             string    `json:"background"`
-	RelatedAnime           []struct {
-		Node                  `json:"node"`
-		RelationType          string `json:"relation_type"`
-		RelationTypeFormatted string `json:"relation_type_formatted"`
-	} `json:"related_anime"`
-	RelatedManga []struct {
-		Node                  `json:"node"`
-		RelationType          string `json:"relation_type"`
-		RelationTypeFormatted string `json:"relation_type_formatted"`
-	} `json:"related_manga"`
-	Recommendations []struct {
-		Node               `json:"node"`
-		NumRecommendations int `json:"num_recommendations"`
-	} `json:"recommendations"`
-	Studios []struct {
-		ID   int    `json:"id"`
-		Name string `json:"name"`
-	} `json:"studios"`
-	Statistics struct {
-		Status struct {
-			Watching    string `json:"watching"`
-			Completed   string `json:"completed"`
-			OnHold      string `json:"on_hold"`
-			Dropped     string `json:"dropped"`
-			PlanToWatch string `json:"plan_to_watch"`
-		} `json:"status"`
-		NumListUsers int `json:"num_list_users"`
-	} `json:"statistics"`
+	ID                     int                   `json:"id"`
+	Title                  string                `json:"title"`
+	MainPicture            Picture               `json:"main_picture"`
+	AlternativeTitles      AlternativeTitles     `json:"alternative_titles"`
+	StartDate              PartialDate           `json:"start_date"`
+	EndDate                PartialDate           `json:"end_date"`
+	Synopsis               string                `json:"synopsis"`
+	Mean                   float64               `json:"mean"`
+	Rank                   int                   `json:"rank"`
+	Popularity             int                   `json:"popularity"`
+	NumListUsers           int                   `json:"num_list_users"`
+	NumScoringUsers        int                   `json:"num_scoring_users"`
+	Nsfw                   NSFW                  `json:"nsfw"`
+	CreatedAt              time.Time             `json:"created_at"`
+	UpdatedAt              time.Time             `json:"updated_at"`
+	MediaType              MediaType             `json:"media_type"`
+	Status                 AiringStatus          `json:"status"`
+	Genres                 []Genre               `json:"genres"`
+	MyListStatus           AnimeListStatus       `json:"my_list_status"`
+	NumEpisodes            int                   `json:"num_episodes"`
+	StartSeason            Season                `json:"start_season"`
+	Broadcast              Broadcast             `json:"broadcast"`
+	Source                 Source                `json:"source"`
+	AverageEpisodeDuration int                   `json:"average_episode_duration"`
+	Rating                 Rating                `json:"rating"`
+	Pictures               []Picture             `json:"pictures"`
+	Background             string                `json:"background"`
+	RelatedAnime           []RelatedAnime        `json:"related_anime"`
+	RelatedManga           []RelatedManga        `json:"related_manga"`
+	Recommendations        []AnimeRecommendation `json:"recommendations"`
+	Studios                []Studio              `json:"studios"`
+	Statistics             AnimeListStatistics   `json:"statistics"`
+	OpeningThemes          []Theme               `json:"opening_themes"`
+	EndingThemes           []Theme               `json:"ending_themes"`
+	Videos                 []Video               `json:"videos"`
+}
+
+// Season is year's season, when anime started airing.
+type Season struct {
+	Year   int        `json:"year"`
+	Season SeasonName `json:"season"`
+}
+
+// Broadcast is weekly airing time of anime in Japan Standard Time.
+type Broadcast struct {
+	DayOfTheWeek string `json:"day_of_the_week"`
+	// StartTime is time in "HH:MM" format, can be empty
+	StartTime string `json:"start_time"`
+}
+
+// Studio is company, produced anime.
+type Studio struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// AnimeListStatistics contains amount of users, who added anime to their lists.
+type AnimeListStatistics struct {
+	Status       StatusStatistics `json:"status"`
+	NumListUsers int              `json:"num_list_users"`
+}
+
+// StatusStatistics is amount of users with anime in every list status.
+type StatusStatistics struct {
+	Watching    int `json:"watching"`
+	Completed   int `json:"completed"`
+	OnHold      int `json:"on_hold"`
+	Dropped     int `json:"dropped"`
+	PlanToWatch int `json:"plan_to_watch"`
+}
+
+// UnmarshalJSON accepts both numbers and numeric strings, which API actually returns.
+func (s *StatusStatistics) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Watching    stringOrInt `json:"watching"`
+		Completed   stringOrInt `json:"completed"`
+		OnHold      stringOrInt `json:"on_hold"`
+		Dropped     stringOrInt `json:"dropped"`
+		PlanToWatch stringOrInt `json:"plan_to_watch"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	values := []struct {
+		src stringOrInt
+		dst *int
+	}{
+		{raw.Watching, &s.Watching},
+		{raw.Completed, &s.Completed},
+		{raw.OnHold, &s.OnHold},
+		{raw.Dropped, &s.Dropped},
+		{raw.PlanToWatch, &s.PlanToWatch},
+	}
+	for _, v := range values {
+		n, err := v.src.int()
+		if err != nil {
+			return err
+		}
+		*v.dst = n
+	}
+	return nil
+}
+
+// Theme is opening or ending song of anime.
+type Theme struct {
+	ID      int `json:"id"`
+	AnimeID int `json:"anime_id"`
+	// Text contains song's title, performer and episodes
+	Text string `json:"text"`
+}
+
+// Video is promotional video of anime.
+type Video struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	Thumbnail string    `json:"thumbnail"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts video's timestamps, which come as seconds since epoch.
+func (v *Video) UnmarshalJSON(data []byte) error {
+	type video Video
+	var raw struct {
+		*video
+		CreatedAt numericDate `json:"created_at"`
+		UpdatedAt numericDate `json:"updated_at"`
+	}
+	raw.video = (*video)(v)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	v.CreatedAt = raw.CreatedAt.time()
+	v.UpdatedAt = raw.UpdatedAt.time()
+	return nil
 }
 
 // AnimeTop returns list of top anime, for each measurement.
@@ -165,8 +291,11 @@ type AnimeDetails struct {
 // Currently available ranks:
 // - RankAll, - RankAiring, - RankUpcoming, - RankTV, - RankOVA,
 // - RankMovie, - RankSpecial, - RankByPopularity, - RankFavorite.
-func (a *Anime) Top(rankingType string, settings PagingSettings) (*AnimeTop, error) {
-	method := http.MethodGet
+func (a *Anime) Top(rankingType string, settings PagingSettings, fields ...Field) (*AnimeTop, error) {
+	return a.top(context.Background(), rankingType, settings, fields...)
+}
+
+func (a *Anime) top(ctx context.Context, rankingType string, settings PagingSettings, fields ...Field) (*AnimeTop, error) {
 	path := "./anime/ranking"
 
 	// Currently working rankings
@@ -178,10 +307,13 @@ func (a *Anime) Top(rankingType string, settings PagingSettings) (*AnimeTop, err
 	data := url.Values{
 		"ranking_type": {rankingType},
 	}
+	if err := setFields(data, a.mal.contentPolicy.withFields(fields, "anime"), animeSchema, "anime"); err != nil {
+		return nil, err
+	}
 	settings.set(&data)
 
 	animeRank := &AnimeTop{parent: a}
-	if err := a.mal.request(animeRank, method, path, data); err != nil {
+	if err := a.mal.requestPage(ctx, animeRank, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 
@@ -191,39 +323,97 @@ func (a *Anime) Top(rankingType string, settings PagingSettings) (*AnimeTop, err
 // AnimeTop contain arrays of Nodes (ID, Title, MainPicture) with their rank position.
 // Use Prev() and Next() methods to retrieve corresponding result pages.
 type AnimeTop struct {
+	listing
 	parent *Anime
-	Data   []struct {
-		Node    `json:"node"`
-		Ranking struct {
-			Rank int `json:"rank"`
-		} `json:"ranking"`
-	} `json:"data"`
-	Paging Paging `json:"paging"`
+	Data   []AnimeRankingEntry `json:"data"`
+	Paging Paging              `json:"paging"`
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *AnimeTop) Next(limit ...int) (result *AnimeTop, err error) {
-	result = &AnimeTop{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *AnimeTop) Next(limit ...int) (*AnimeTop, error) {
+	return adjacentPage[*AnimeTop, AnimeRankingEntry](obj, 1, limit)
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *AnimeTop) Prev(limit ...int) (result *AnimeTop, err error) {
-	result = &AnimeTop{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *AnimeTop) Prev(limit ...int) (*AnimeTop, error) {
+	return adjacentPage[*AnimeTop, AnimeRankingEntry](obj, -1, limit)
+}
+
+// HasNext reports whether there is next result page.
+func (obj *AnimeTop) HasNext() bool {
+	return obj.Paging.Next != ""
+}
+
+// HasPrev reports whether there is previous result page.
+func (obj *AnimeTop) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
+
+// Items returns entries of current page.
+func (obj *AnimeTop) Items() []AnimeRankingEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *AnimeTop) Iter() *Iterator[AnimeRankingEntry] {
+	return newIterator[AnimeRankingEntry](obj)
+}
+
+func (obj *AnimeTop) emptyPage() page[AnimeRankingEntry] {
+	return &AnimeTop{parent: obj.parent}
+}
+
+func (obj *AnimeTop) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *AnimeTop) client() *MAL {
+	return obj.parent.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *AnimeTop) FetchAll(settings FetchAllSettings) ([]AnimeRankingEntry, error) {
+	return fetchAll[AnimeRankingEntry](context.Background(), obj, settings, func(entry AnimeRankingEntry) int { return entry.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *AnimeTop) Stream(ctx context.Context) *Stream[AnimeRankingEntry] {
+	return newStream[AnimeRankingEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *AnimeTop) Offset(k int) (*AnimeTop, error) {
+	return offsetPage[*AnimeTop, AnimeRankingEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *AnimeTop) First() (*AnimeTop, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *AnimeTop) Page(n int) (*AnimeTop, error) {
+	return numberedPage[*AnimeTop, AnimeRankingEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *AnimeTop) CurrentPage() int {
+	return currentPage[AnimeRankingEntry](obj)
 }
 
 // SeasonalAnime returns list of anime from certain year's season.
 // Season are required. Rest fields are optional.
+// FieldStartSeason is always added to fields, so StartSeason of entries is filled
+// and they can be classified with AnimeSeasonal.Class().
 // For additional info see https://myanimelist.net/apiconfig/references/api/v2#operation/anime_ranking_get
-func (a *Anime) Seasonal(year int, season string, sort string, settings PagingSettings) (*AnimeSeasonal, error) {
+func (a *Anime) Seasonal(year int, season SeasonName, sort string, settings PagingSettings, fields ...Field) (*AnimeSeasonal, error) {
 	// Available season values
-	acceptable := makeList(seasons)
-	if _, ok := acceptable[season]; !ok {
+	if !season.Valid() {
 		return nil, errors.New("undefined season")
 	}
 	// Available sort values
@@ -233,7 +423,6 @@ func (a *Anime) Seasonal(year int, season string, sort string, settings PagingSe
 		return nil, errors.New("invalid year")
 	}
 
-	method := http.MethodGet
 	path := fmt.Sprintf("./anime/season/%d/%s", year, season)
 	data := url.Values{}
 	if sort != "" {
@@ -241,10 +430,14 @@ func (a *Anime) Seasonal(year int, season string, sort string, settings PagingSe
 			data.Set("sort", sort)
 		}
 	}
+	fields = append(append([]Field(nil), fields...), FieldStartSeason)
+	if err := setFields(data, a.mal.contentPolicy.withFields(fields, "anime"), animeSchema, "anime"); err != nil {
+		return nil, err
+	}
 	settings.set(&data)
 
 	seasonal := &AnimeSeasonal{parent: a}
-	if err := a.mal.request(seasonal, method, path, data); err != nil {
+	if err := a.mal.requestPage(context.Background(), seasonal, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 
@@ -254,44 +447,105 @@ func (a *Anime) Seasonal(year int, season string, sort string, settings PagingSe
 // AnimeSeasonal contain array with basic anime nodes (ID, Title, MainPicture).
 // Use Prev() and Next() methods to retrieve corresponding result pages.
 type AnimeSeasonal struct {
+	listing
 	parent *Anime
-	Data   []struct {
-		Node `json:"node"`
-	} `json:"data"`
-	Paging Paging `json:"paging"`
-	Season struct {
-		Year   int    `json:"year"`
-		Season string `json:"season"`
-	} `json:"season"`
+	// classes are kept by view, returned by Only(). Nil means all classes
+	classes map[SeasonalClass]struct{}
+	Data    []AnimeEntry `json:"data"`
+	Paging  Paging       `json:"paging"`
+	Season  Season       `json:"season"`
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *AnimeSeasonal) Next(limit ...int) (result *AnimeSeasonal, err error) {
-	result = &AnimeSeasonal{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *AnimeSeasonal) Next(limit ...int) (*AnimeSeasonal, error) {
+	return adjacentPage[*AnimeSeasonal, AnimeEntry](obj, 1, limit)
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *AnimeSeasonal) Prev(limit ...int) (result *AnimeSeasonal, err error) {
-	result = &AnimeSeasonal{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *AnimeSeasonal) Prev(limit ...int) (*AnimeSeasonal, error) {
+	return adjacentPage[*AnimeSeasonal, AnimeEntry](obj, -1, limit)
+}
+
+// HasNext reports whether there is next result page.
+func (obj *AnimeSeasonal) HasNext() bool {
+	return obj.Paging.Next != ""
+}
+
+// HasPrev reports whether there is previous result page.
+func (obj *AnimeSeasonal) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
+
+// Items returns entries of current page.
+func (obj *AnimeSeasonal) Items() []AnimeEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *AnimeSeasonal) Iter() *Iterator[AnimeEntry] {
+	return newIterator[AnimeEntry](obj)
+}
+
+func (obj *AnimeSeasonal) emptyPage() page[AnimeEntry] {
+	return &AnimeSeasonal{parent: obj.parent, classes: obj.classes}
+}
+
+func (obj *AnimeSeasonal) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *AnimeSeasonal) client() *MAL {
+	return obj.parent.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *AnimeSeasonal) FetchAll(settings FetchAllSettings) ([]AnimeEntry, error) {
+	return fetchAll[AnimeEntry](context.Background(), obj, settings, func(entry AnimeEntry) int { return entry.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *AnimeSeasonal) Stream(ctx context.Context) *Stream[AnimeEntry] {
+	return newStream[AnimeEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *AnimeSeasonal) Offset(k int) (*AnimeSeasonal, error) {
+	return offsetPage[*AnimeSeasonal, AnimeEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *AnimeSeasonal) First() (*AnimeSeasonal, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *AnimeSeasonal) Page(n int) (*AnimeSeasonal, error) {
+	return numberedPage[*AnimeSeasonal, AnimeEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *AnimeSeasonal) CurrentPage() int {
+	return currentPage[AnimeEntry](obj)
 }
 
 // SuggestedAnime returns suggested anime for the authorized user.
 // If the user is new comer, expect to receive empty result.
-func (a *Anime) Suggestions(settings PagingSettings) (*AnimeSuggestions, error) {
-	method := http.MethodGet
+func (a *Anime) Suggestions(settings PagingSettings, fields ...Field) (*AnimeSuggestions, error) {
 	path := "./anime/suggestions"
 
 	data := url.Values{}
+	if err := setFields(data, a.mal.contentPolicy.withFields(fields, "anime"), animeSchema, "anime"); err != nil {
+		return nil, err
+	}
 	settings.set(&data)
 
 	suggestions := &AnimeSuggestions{parent: a}
-	if err := a.mal.request(suggestions, method, path, data); err != nil {
+	if err := a.mal.requestPage(context.Background(), suggestions, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 
@@ -301,27 +555,105 @@ func (a *Anime) Suggestions(settings PagingSettings) (*AnimeSuggestions, error)
 // SuggestedAnime contain arrays of anime Nodes (ID, Title, MainPicture), suggested for current user.
 // Use Prev() and Next() methods to retrieve corresponding result pages.
 type AnimeSuggestions struct {
+	listing
 	parent *Anime
-	Data   []struct {
-		Node `json:"node"`
-	} `json:"data"`
-	Paging Paging `json:"paging"`
+	Data   []AnimeEntry `json:"data"`
+	Paging Paging       `json:"paging"`
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *AnimeSuggestions) Prev(limit ...int) (result *AnimeSuggestions, err error) {
-	result = &AnimeSuggestions{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *AnimeSuggestions) Prev(limit ...int) (*AnimeSuggestions, error) {
+	return adjacentPage[*AnimeSuggestions, AnimeEntry](obj, -1, limit)
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *AnimeSuggestions) Next(limit ...int) (result *AnimeSuggestions, err error) {
-	result = &AnimeSuggestions{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *AnimeSuggestions) Next(limit ...int) (*AnimeSuggestions, error) {
+	return adjacentPage[*AnimeSuggestions, AnimeEntry](obj, 1, limit)
+}
+
+// HasNext reports whether there is next result page.
+func (obj *AnimeSuggestions) HasNext() bool {
+	return obj.Paging.Next != ""
+}
+
+// HasPrev reports whether there is previous result page.
+func (obj *AnimeSuggestions) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
+
+// Items returns entries of current page.
+func (obj *AnimeSuggestions) Items() []AnimeEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *AnimeSuggestions) Iter() *Iterator[AnimeEntry] {
+	return newIterator[AnimeEntry](obj)
+}
+
+func (obj *AnimeSuggestions) emptyPage() page[AnimeEntry] {
+	return &AnimeSuggestions{parent: obj.parent}
+}
+
+func (obj *AnimeSuggestions) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *AnimeSuggestions) client() *MAL {
+	return obj.parent.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *AnimeSuggestions) FetchAll(settings FetchAllSettings) ([]AnimeEntry, error) {
+	return fetchAll[AnimeEntry](context.Background(), obj, settings, func(entry AnimeEntry) int { return entry.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *AnimeSuggestions) Stream(ctx context.Context) *Stream[AnimeEntry] {
+	return newStream[AnimeEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *AnimeSuggestions) Offset(k int) (*AnimeSuggestions, error) {
+	return offsetPage[*AnimeSuggestions, AnimeEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *AnimeSuggestions) First() (*AnimeSuggestions, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *AnimeSuggestions) Page(n int) (*AnimeSuggestions, error) {
+	return numberedPage[*AnimeSuggestions, AnimeEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *AnimeSuggestions) CurrentPage() int {
+	return currentPage[AnimeEntry](obj)
+}
+
+// AnimeEntry is single anime of search result, seasonal or suggestions list.
+// By default only ID, Title and MainPicture are filled,
+// request additional fields with field selection of corresponding method.
+type AnimeEntry struct {
+	AnimeDetails `json:"node"`
+}
+
+// AnimeRankingEntry is single anime of top list with its rank position.
+type AnimeRankingEntry struct {
+	AnimeDetails `json:"node"`
+	Ranking      Ranking `json:"ranking"`
+}
+
+// Ranking contain rank position of anime or manga.
+type Ranking struct {
+	Rank int `json:"rank"`
 }
 
 // Node type is basic container for anime or manga
@@ -340,3 +672,40 @@ type Genre struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
+
+// AlternativeTitles contains other titles of anime or manga.
+type AlternativeTitles struct {
+	Synonyms []string `json:"synonyms"`
+	En       string   `json:"en"`
+	Ja       string   `json:"ja"`
+}
+
+// RelatedAnime is anime, related to another anime or manga.
+// By default only ID, Title and MainPicture are filled,
+// request more with sub-fields of FieldRelatedAnime.
+type RelatedAnime struct {
+	AnimeDetails          `json:"node"`
+	RelationType          RelationType `json:"relation_type"`
+	RelationTypeFormatted string       `json:"relation_type_formatted"`
+}
+
+// RelatedManga is manga, related to another anime or manga.
+// By default only ID, Title and MainPicture are filled,
+// request more with sub-fields of FieldRelatedManga.
+type RelatedManga struct {
+	MangaDetails          `json:"node"`
+	RelationType          RelationType `json:"relation_type"`
+	RelationTypeFormatted string       `json:"relation_type_formatted"`
+}
+
+// AnimeRecommendation is anime, recommended by users for fans of another anime.
+type AnimeRecommendation struct {
+	AnimeDetails       `json:"node"`
+	NumRecommendations int `json:"num_recommendations"`
+}
+
+// MangaRecommendation is manga, recommended by users for fans of another manga.
+type MangaRecommendation struct {
+	MangaDetails       `json:"node"`
+	NumRecommendations int `json:"num_recommendations"`
+}
@@ -1,6 +1,7 @@
 package myanimelist
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 )
@@ -122,7 +123,7 @@ func TestAnimeSearchResult_Prev(t *testing.T) {
 func TestMAL_Anime_Details(t *testing.T) {
 	type args struct {
 		animeID int
-		fields  []string
+		fields  []Field
 	}
 	tests := []struct {
 		name    string
@@ -132,7 +133,7 @@ func TestMAL_Anime_Details(t *testing.T) {
 	}{
 		{
 			name: "FMA: Brotherhood",
-			args: args{5114, []string{FieldTitle}},
+			args: args{5114, []Field{FieldTitle}},
 			want: &AnimeDetails{
 				ID:    5114,
 				Title: "Fullmetal Alchemist: Brotherhood",
@@ -274,7 +275,7 @@ func TestAnimeTop_Prev(t *testing.T) {
 func TestMAL_Anime_Seasonal(t *testing.T) {
 	type args struct {
 		year     int
-		season   string
+		season   SeasonName
 		sort     string
 		settings PagingSettings
 	}
@@ -499,3 +500,52 @@ func TestAnimeSuggestions_Prev(t *testing.T) {
 		})
 	}
 }
+
+func TestAnimeDetails_Unmarshal(t *testing.T) {
+	data := []byte(`{
+		"id": 1,
+		"title": "Cowboy Bebop",
+		"alternative_titles": {"synonyms": [], "en": "Cowboy Bebop", "ja": "カウボーイビバップ"},
+		"start_season": {"year": 1998, "season": "spring"},
+		"broadcast": {"day_of_the_week": "saturday", "start_time": "01:00"},
+		"my_list_status": {"status": "completed", "num_episodes_watched": 26, "start_date": "2020-01",
+			"priority": 2, "num_times_rewatched": 1, "rewatch_value": 4, "tags": ["space"], "comments": "classic"},
+		"related_anime": [{"node": {"id": 5, "title": "Movie", "num_episodes": 1}, "relation_type": "side_story"}],
+		"recommendations": [{"node": {"id": 205, "title": "Samurai Champloo"}, "num_recommendations": 70}],
+		"studios": [{"id": 14, "name": "Sunrise"}],
+		"statistics": {"status": {"watching": "53253", "completed": 812000, "on_hold": "31", "dropped": "", "plan_to_watch": "1"},
+			"num_list_users": 900000},
+		"opening_themes": [{"id": 1, "anime_id": 1, "text": "\"Tank!\" by The Seatbelts"}],
+		"videos": [{"id": 2, "title": "PV", "url": "https://example.com", "created_at": 1600000000, "updated_at": 0}]
+	}`)
+
+	var details AnimeDetails
+	if err := json.Unmarshal(data, &details); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if details.AlternativeTitles.Ja == "" || details.StartSeason.Year != 1998 || details.Broadcast.StartTime != "01:00" {
+		t.Errorf("Unmarshal() titles/season/broadcast = %+v %+v %+v",
+			details.AlternativeTitles, details.StartSeason, details.Broadcast)
+	}
+	ls := details.MyListStatus
+	if ls.NumWatchedEpisodes != 26 || ls.Priority != 2 || ls.NumTimesRewatched != 1 || ls.RewatchValue != 4 ||
+		ls.StartDate != NewPartialDate(2020, 1, 0) || len(ls.Tags) != 1 || ls.Comments != "classic" {
+		t.Errorf("Unmarshal() list status = %+v", ls)
+	}
+	if len(details.RelatedAnime) != 1 || details.RelatedAnime[0].NumEpisodes != 1 ||
+		details.RelatedAnime[0].RelationType != "side_story" {
+		t.Errorf("Unmarshal() related anime = %+v", details.RelatedAnime)
+	}
+	if details.Recommendations[0].ID != 205 || details.Studios[0].Name != "Sunrise" {
+		t.Errorf("Unmarshal() recommendations/studios = %+v %+v", details.Recommendations, details.Studios)
+	}
+	want := StatusStatistics{Watching: 53253, Completed: 812000, OnHold: 31, PlanToWatch: 1}
+	if details.Statistics.Status != want {
+		t.Errorf("Unmarshal() statistics = %+v, want %+v", details.Statistics.Status, want)
+	}
+	if details.OpeningThemes[0].AnimeID != 1 || details.Videos[0].CreatedAt.Unix() != 1600000000 ||
+		!details.Videos[0].UpdatedAt.IsZero() {
+		t.Errorf("Unmarshal() themes/videos = %+v %+v", details.OpeningThemes, details.Videos)
+	}
+}
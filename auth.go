@@ -40,13 +40,21 @@ func (a *Auth) LoginURL() string {
 	a.codeVerifier = codeVerifier()
 	a.codeChallenge = codeChallenge(a.codeVerifier, codeChallengePlain)
 
+	return a.loginURL(a.codeChallenge, "")
+}
+
+// loginURL builds authorization URL with provided PKCE challenge and optional state.
+func (a *Auth) loginURL(challenge string, state string) string {
 	reqURL, _ := url.Parse(authorizeEndpoint)
 
 	q := reqURL.Query()
 	q.Set("response_type", "code")
 	q.Set("client_id", a.clientID)
 	q.Set("redirect_uri", a.redirectURL)
-	q.Set("code_challenge", a.codeChallenge)
+	q.Set("code_challenge", challenge)
+	if state != "" {
+		q.Set("state", state)
+	}
 
 	reqURL.RawQuery = q.Encode()
 
@@ -56,17 +64,35 @@ func (a *Auth) LoginURL() string {
 // RetrieveToken use received from user's authorization code and send
 // it to server to receive user access token
 func (a *Auth) ExchangeToken(authCode string) (*UserCredentials, error) {
-	method := http.MethodPost
-	path := tokenEndpoint
+	user, err := a.exchangeToken(authCode, a.codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	a.SetTokenInfo(user.AccessToken, user.RefreshToken, user.ExpireAt)
+
+	return user, nil
+}
+
+// exchangeToken exchanges authorization code for user's tokens, without saving them.
+func (a *Auth) exchangeToken(authCode string, verifier string) (*UserCredentials, error) {
 	data := url.Values{
 		"client_id":     {a.clientID},
 		"client_secret": {a.clientSecret},
 		"grant_type":    {"authorization_code"},
 		"code":          {authCode},
 		"redirect_uri":  {a.redirectURL},
-		"code_verifier": {a.codeVerifier},
+		"code_verifier": {verifier},
 	}
 
+	return a.requestToken(data)
+}
+
+// requestToken sends data to token endpoint and converts response into UserCredentials.
+func (a *Auth) requestToken(data url.Values) (*UserCredentials, error) {
+	method := http.MethodPost
+	path := tokenEndpoint
+
 	tokenResp := new(tokenResponse)
 	if err := a.mal.request(tokenResp, method, path, data); err != nil {
 		return nil, err
@@ -85,8 +111,6 @@ func (a *Auth) ExchangeToken(authCode string) (*UserCredentials, error) {
 		RefreshToken: tokenResp.RefreshToken,
 		ExpireAt:     expireAt,
 	}
-	a.SetTokenInfo(user.AccessToken, user.RefreshToken, user.ExpireAt)
-
 	return user, nil
 }
 
@@ -123,8 +147,6 @@ func codeChallenge(code string, _ codeChallengeMethod) string {
 }
 
 func (a *Auth) RefreshToken() (*UserCredentials, error) {
-	method := http.MethodPost
-	path := tokenEndpoint
 	data := url.Values{
 		"grant_type":    {"refresh_token"},
 		"refresh_token": {a.refreshToken},
@@ -132,25 +154,11 @@ func (a *Auth) RefreshToken() (*UserCredentials, error) {
 		"client_secret": {a.clientSecret},
 	}
 
-	tokenResp := new(tokenResponse)
-	if err := a.mal.request(tokenResp, method, path, data); err != nil {
-		return nil, err
-	}
-
-	expirationDuration, err := time.ParseDuration(fmt.Sprintf("%ds", tokenResp.ExpiresIn))
+	user, err := a.requestToken(data)
 	if err != nil {
-		a.mal.logger.Printf("Parse duration error: %s\n", err)
 		return nil, err
 	}
 
-	expireAt := time.Now().Add(expirationDuration)
-
-	user := &UserCredentials{
-		AccessToken:  tokenResp.AccessToken,
-		RefreshToken: tokenResp.RefreshToken,
-		ExpireAt:     expireAt,
-	}
-
 	a.SetTokenInfo(user.AccessToken, user.RefreshToken, user.ExpireAt)
 	return user, nil
 }
@@ -173,7 +181,41 @@ func (a *Auth) GetTokenInfo() *UserCredentials {
 
 // SetTokenInfo completely rewrites saved user's credentials, so use it very careful.
 // In case you erased correct tokens - lead user to authorization page again.
+// If access token is JWT, zero expire time replaced with token's own expiration time.
+// Malformed and already expired tokens are still saved, but warning is logged.
+// To reject malformed tokens use RestoreTokenInfo.
 func (a *Auth) SetTokenInfo(accessToken string, refreshToken string, expire time.Time) {
+	claims, err := ParseToken(accessToken)
+	if err != nil {
+		a.mal.logger.Printf("access token isn't valid JWT: %s\n", err)
+	} else if expire.IsZero() {
+		expire = claims.ExpireAt
+	}
+	a.setTokenInfo(accessToken, refreshToken, expire)
+}
+
+// RestoreTokenInfo works like SetTokenInfo, but access token is decoded first and
+// rejected with error, if it's malformed. Use it for tokens, saved somewhere by your application.
+// Zero expire time replaced with token's own expiration time.
+// Already expired tokens still saved (so you can use RefreshToken()), but warning is logged.
+func (a *Auth) RestoreTokenInfo(accessToken string, refreshToken string, expire time.Time) error {
+	claims, err := ParseToken(accessToken)
+	if err != nil {
+		return err
+	}
+
+	if expire.IsZero() {
+		expire = claims.ExpireAt
+	}
+	a.setTokenInfo(accessToken, refreshToken, expire)
+	return nil
+}
+
+func (a *Auth) setTokenInfo(accessToken string, refreshToken string, expire time.Time) {
+	if !expire.IsZero() && !time.Now().Before(expire) {
+		a.mal.logger.Printf("access token already expired at %s\n", expire.Format(time.RFC3339))
+	}
+
 	a.userToken = accessToken
 	a.refreshToken = refreshToken
 	a.tokenExpireAt = expire
@@ -0,0 +1,232 @@
+package myanimelist
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrNoLoginSession returned when callback request has no pending login.
+// Usually means user opened callback URL directly or session already expired.
+var ErrNoLoginSession = errors.New("no pending login session")
+
+// ErrStateMismatch returned when state, received at callback, differs from saved one.
+var ErrStateMismatch = errors.New("login state mismatch")
+
+// LoginError describes error, returned by MyAnimeList to callback URL.
+// For example, when user denied access to their account.
+type LoginError struct {
+	Err         string
+	Description string
+}
+
+func (e *LoginError) Error() string {
+	return fmt.Sprintf("myanimelist authorization failed: %s. With message: %s", e.Err, e.Description)
+}
+
+// LoginState contains data of pending login, which must survive
+// between redirecting user to MyAnimeList and their return to callback URL.
+type LoginState struct {
+	State        string
+	CodeVerifier string
+}
+
+// SessionStore keeps pending login states between login and callback requests.
+type SessionStore interface {
+	// Save stores state for user of provided request.
+	Save(w http.ResponseWriter, r *http.Request, state LoginState) error
+	// Load returns state, saved for user of provided request.
+	// Should return ErrNoLoginSession if there is nothing to load.
+	Load(r *http.Request) (LoginState, error)
+	// Delete removes state of user of provided request.
+	Delete(w http.ResponseWriter, r *http.Request) error
+}
+
+// LoginHandler runs whole web OAuth flow: redirects user to MyAnimeList's login page
+// and exchanges received code for user's tokens at callback URL.
+// Received tokens are NOT saved into Auth, because web application usually
+// serves many users at once - save them at OnSuccess instead.
+type LoginHandler struct {
+	auth *Auth
+
+	// Sessions keeps pending logins
+	Sessions SessionStore
+	// OnSuccess called with credentials of logged in user
+	OnSuccess func(w http.ResponseWriter, r *http.Request, credentials *UserCredentials)
+	// OnFailure called with reason of failed login
+	OnFailure func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// NewLoginHandler creates LoginHandler for your web application.
+// Mount LoginHandler.Login() to your login route and LoginHandler.Callback() to route
+// of RedirectURL, provided at New(). If sessions is nil, in-memory store is used.
+func (a *Auth) NewLoginHandler(sessions SessionStore,
+	onSuccess func(w http.ResponseWriter, r *http.Request, credentials *UserCredentials),
+	onFailure func(w http.ResponseWriter, r *http.Request, err error)) (*LoginHandler, error) {
+	if onSuccess == nil {
+		return nil, errors.New("onSuccess callback is required")
+	}
+	if onFailure == nil {
+		return nil, errors.New("onFailure callback is required")
+	}
+	if sessions == nil {
+		sessions = NewMemorySessionStore()
+	}
+
+	return &LoginHandler{
+		auth:      a,
+		Sessions:  sessions,
+		OnSuccess: onSuccess,
+		OnFailure: onFailure,
+	}, nil
+}
+
+// Login returns handler, which starts new login and redirects user to MyAnimeList.
+func (h *LoginHandler) Login() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verifier := codeVerifier()
+		state := LoginState{
+			State:        randomString(32, []byte(stateSymbols)),
+			CodeVerifier: verifier,
+		}
+		if verifier == "" || state.State == "" {
+			h.OnFailure(w, r, errors.New("can't generate login codes"))
+			return
+		}
+
+		if err := h.Sessions.Save(w, r, state); err != nil {
+			h.OnFailure(w, r, err)
+			return
+		}
+
+		loginURL := h.auth.loginURL(codeChallenge(verifier, codeChallengePlain), state.State)
+		http.Redirect(w, r, loginURL, http.StatusFound)
+	})
+}
+
+// Callback returns handler for RedirectURL, which finishes login.
+func (h *LoginHandler) Callback() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state, err := h.Sessions.Load(r)
+		if err != nil {
+			h.OnFailure(w, r, err)
+			return
+		}
+		if err := h.Sessions.Delete(w, r); err != nil {
+			h.OnFailure(w, r, err)
+			return
+		}
+
+		if errCode := r.FormValue("error"); errCode != "" {
+			h.OnFailure(w, r, &LoginError{Err: errCode, Description: r.FormValue("error_description")})
+			return
+		}
+		if r.FormValue("state") != state.State {
+			h.OnFailure(w, r, ErrStateMismatch)
+			return
+		}
+
+		code := r.FormValue("code")
+		if code == "" {
+			h.OnFailure(w, r, errors.New("authorization code is missing"))
+			return
+		}
+
+		credentials, err := h.auth.exchangeToken(code, state.CodeVerifier)
+		if err != nil {
+			h.OnFailure(w, r, err)
+			return
+		}
+		h.OnSuccess(w, r, credentials)
+	})
+}
+
+const stateSymbols = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// MemorySessionStore keeps pending logins in memory, identifying users by cookie.
+// Suitable for single-instance applications.
+type MemorySessionStore struct {
+	// CookieName is name of cookie with session ID
+	CookieName string
+	// TTL is how long pending login stays valid
+	TTL time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]memorySession
+}
+
+type memorySession struct {
+	state    LoginState
+	expireAt time.Time
+}
+
+// NewMemorySessionStore creates MemorySessionStore with 10 minutes TTL.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{
+		CookieName: "mal_login",
+		TTL:        10 * time.Minute,
+		sessions:   make(map[string]memorySession),
+	}
+}
+
+func (s *MemorySessionStore) Save(w http.ResponseWriter, r *http.Request, state LoginState) error {
+	id := randomString(32, []byte(stateSymbols))
+	if id == "" {
+		return errors.New("can't generate session ID")
+	}
+
+	now := time.Now()
+	s.mu.Lock()
+	// drop expired sessions, so abandoned logins don't pile up
+	for k, v := range s.sessions {
+		if now.After(v.expireAt) {
+			delete(s.sessions, k)
+		}
+	}
+	s.sessions[id] = memorySession{state: state, expireAt: now.Add(s.TTL)}
+	s.mu.Unlock()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     s.CookieName,
+		Value:    id,
+		Path:     "/",
+		MaxAge:   int(s.TTL.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+func (s *MemorySessionStore) Load(r *http.Request) (LoginState, error) {
+	cookie, err := r.Cookie(s.CookieName)
+	if err != nil {
+		return LoginState{}, ErrNoLoginSession
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[cookie.Value]
+	if !ok || time.Now().After(session.expireAt) {
+		return LoginState{}, ErrNoLoginSession
+	}
+	return session.state, nil
+}
+
+func (s *MemorySessionStore) Delete(w http.ResponseWriter, r *http.Request) error {
+	if cookie, err := r.Cookie(s.CookieName); err == nil {
+		s.mu.Lock()
+		delete(s.sessions, cookie.Value)
+		s.mu.Unlock()
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:   s.CookieName,
+		Value:  "",
+		Path:   "/",
+		MaxAge: -1,
+	})
+	return nil
+}
@@ -0,0 +1,177 @@
+package myanimelist
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// fakeTokenServer emulates MyAnimeList's token endpoint.
+// It accepts only "good_code" authorization code.
+func fakeTokenServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("fake token endpoint: %s", err)
+		}
+		if r.Header.Get("Authorization") != "" {
+			t.Error("fake token endpoint: got unexpected Authorization header")
+		}
+		if r.PostForm.Get("code") != "good_code" || r.PostForm.Get("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(errorResponse{Err: "invalid_grant", Message: "bad code"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(tokenResponse{
+			TokenType:    "Bearer",
+			ExpiresIn:    2678400,
+			AccessToken:  generateToken(`{"sub":"1","exp":4102444800}`),
+			RefreshToken: "refresh_" + r.PostForm.Get("code_verifier")[:8],
+		})
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestLoginHandler(t *testing.T) {
+	server := fakeTokenServer(t)
+	defer server.Close()
+
+	oldEndpoint := tokenEndpoint
+	tokenEndpoint = server.URL + "/v1/oauth2/token"
+	defer func() { tokenEndpoint = oldEndpoint }()
+
+	mal, err := New(Config{ClientID: "mock", ClientSecret: "mock", RedirectURL: "https://example.com/callback"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		code      string
+		state     func(saved string) string
+		useCookie bool
+		wantErr   error
+	}{
+		{
+			name:      "Successful login",
+			code:      "good_code",
+			state:     func(saved string) string { return saved },
+			useCookie: true,
+		},
+		{
+			name:      "Wrong state",
+			code:      "good_code",
+			state:     func(string) string { return "forged" },
+			useCookie: true,
+			wantErr:   ErrStateMismatch,
+		},
+		{
+			name:      "No session",
+			code:      "good_code",
+			state:     func(saved string) string { return saved },
+			useCookie: false,
+			wantErr:   ErrNoLoginSession,
+		},
+		{
+			name:      "Rejected code",
+			code:      "bad_code",
+			state:     func(saved string) string { return saved },
+			useCookie: true,
+			wantErr:   &errorResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotCredentials *UserCredentials
+			var gotErr error
+			handler, err := mal.Auth.NewLoginHandler(nil,
+				func(w http.ResponseWriter, r *http.Request, credentials *UserCredentials) {
+					gotCredentials = credentials
+				},
+				func(w http.ResponseWriter, r *http.Request, err error) {
+					gotErr = err
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			loginResp := httptest.NewRecorder()
+			handler.Login().ServeHTTP(loginResp, httptest.NewRequest(http.MethodGet, "/login", nil))
+			if loginResp.Code != http.StatusFound {
+				t.Fatalf("Login() status = %d, want %d", loginResp.Code, http.StatusFound)
+			}
+			loginURL, err := url.Parse(loginResp.Header().Get("Location"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			savedState := loginURL.Query().Get("state")
+			if savedState == "" || loginURL.Query().Get("code_challenge") == "" {
+				t.Fatalf("Login() redirected to %s, want state and code_challenge", loginURL)
+			}
+
+			query := url.Values{"code": {tt.code}, "state": {tt.state(savedState)}}
+			callbackReq := httptest.NewRequest(http.MethodGet, "/callback?"+query.Encode(), nil)
+			if tt.useCookie {
+				for _, c := range loginResp.Result().Cookies() {
+					callbackReq.AddCookie(c)
+				}
+			}
+			handler.Callback().ServeHTTP(httptest.NewRecorder(), callbackReq)
+
+			if tt.wantErr != nil {
+				var apiErr *errorResponse
+				if errors.As(tt.wantErr, &apiErr) {
+					if !errors.As(gotErr, &apiErr) {
+						t.Errorf("Callback() error = %v, want api error", gotErr)
+					}
+				} else if !errors.Is(gotErr, tt.wantErr) {
+					t.Errorf("Callback() error = %v, want %v", gotErr, tt.wantErr)
+				}
+				if gotCredentials != nil {
+					t.Error("Callback() called OnSuccess on failure")
+				}
+				return
+			}
+			if gotErr != nil {
+				t.Fatalf("Callback() error = %v", gotErr)
+			}
+			if gotCredentials == nil || gotCredentials.AccessToken == "" {
+				t.Fatalf("Callback() got credentials = %+v", gotCredentials)
+			}
+			if mal.Auth.GetTokenInfo().AccessToken != "" {
+				t.Error("Callback() saved user's tokens into shared client")
+			}
+		})
+	}
+}
+
+func TestLoginHandler_Denied(t *testing.T) {
+	mal, err := New(Config{ClientID: "mock", ClientSecret: "mock", RedirectURL: "/callback"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotErr error
+	handler, err := mal.Auth.NewLoginHandler(nil,
+		func(w http.ResponseWriter, r *http.Request, credentials *UserCredentials) {},
+		func(w http.ResponseWriter, r *http.Request, err error) { gotErr = err })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loginResp := httptest.NewRecorder()
+	handler.Login().ServeHTTP(loginResp, httptest.NewRequest(http.MethodGet, "/login", nil))
+
+	callbackReq := httptest.NewRequest(http.MethodGet, "/callback?error=access_denied", nil)
+	for _, c := range loginResp.Result().Cookies() {
+		callbackReq.AddCookie(c)
+	}
+	handler.Callback().ServeHTTP(httptest.NewRecorder(), callbackReq)
+
+	var loginErr *LoginError
+	if !errors.As(gotErr, &loginErr) || loginErr.Err != "access_denied" {
+		t.Errorf("Callback() error = %v, want access_denied LoginError", gotErr)
+	}
+}
@@ -0,0 +1,128 @@
+package myanimelist
+
+import (
+	"context"
+	"net/url"
+	"sync"
+)
+
+// Batch is result of DetailsMany methods.
+// Every requested ID ends up either in Results or in Errors.
+type Batch[T any] struct {
+	// Results contains successfully fetched objects by their IDs
+	Results map[int]T
+	// Errors contains errors of failed IDs. Use errors.Is() with ErrNotFound or ErrRateLimited
+	// to find out the reason
+	Errors map[int]error
+}
+
+// Failed returns IDs, which weren't fetched.
+func (b *Batch[T]) Failed() []int {
+	ids := make([]int, 0, len(b.Errors))
+	for id := range b.Errors {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// BatchSettings controls DetailsMany methods.
+type BatchSettings struct {
+	// Concurrency is amount of requests made at once. Default is 4.
+	// All requests still obey Config.RequestInterval
+	Concurrency int
+}
+
+// DetailsMany returns details about multiple anime at once.
+// Failure of single ID doesn't stop others, it's reported in Batch.Errors instead.
+// Error is returned only for invalid fields or when ctx is done,
+// in the last case batch contains everything fetched before and ctx.Err() for the rest of IDs.
+func (a *Anime) DetailsMany(ctx context.Context, animeIDs []int, settings BatchSettings, fields ...Field) (*Batch[*AnimeDetails], error) {
+	data := url.Values{}
+	if err := setFields(data, fields, animeSchema, "anime"); err != nil {
+		return nil, err
+	}
+	return detailsMany(ctx, animeIDs, settings, func(ctx context.Context, id int) (*AnimeDetails, error) {
+		return a.details(ctx, id, data)
+	})
+}
+
+// DetailsMany returns details about multiple manga at once.
+// Failure of single ID doesn't stop others, it's reported in Batch.Errors instead.
+// Error is returned only for invalid fields or when ctx is done,
+// in the last case batch contains everything fetched before and ctx.Err() for the rest of IDs.
+func (m *Manga) DetailsMany(ctx context.Context, mangaIDs []int, settings BatchSettings, fields ...Field) (*Batch[*MangaDetails], error) {
+	data := url.Values{}
+	if err := setFields(data, fields, mangaSchema, "manga"); err != nil {
+		return nil, err
+	}
+	return detailsMany(ctx, mangaIDs, settings, func(ctx context.Context, id int) (*MangaDetails, error) {
+		return m.details(ctx, id, data)
+	})
+}
+
+// detailsMany calls fetch for every unique ID with bounded concurrency.
+func detailsMany[T any](ctx context.Context, ids []int, settings BatchSettings, fetch func(ctx context.Context, id int) (T, error)) (*Batch[T], error) {
+	concurrency := settings.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	batch := &Batch[T]{
+		Results: make(map[int]T, len(ids)),
+		Errors:  make(map[int]error),
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	queue := make(chan int)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				result, err := fetch(ctx, id)
+				if ctx.Err() != nil {
+					// don't blame ID for cancellation
+					continue
+				}
+				mu.Lock()
+				if err != nil {
+					batch.Errors[id] = err
+				} else {
+					batch.Results[id] = result
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[int]struct{}, len(ids))
+feed:
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		select {
+		case queue <- id:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		// IDs, which weren't fetched because of cancellation, fail with its reason
+		for _, id := range ids {
+			if _, ok := batch.Results[id]; ok {
+				continue
+			}
+			if _, ok := batch.Errors[id]; !ok {
+				batch.Errors[id] = err
+			}
+		}
+		return batch, err
+	}
+	return batch, nil
+}
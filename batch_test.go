@@ -0,0 +1,103 @@
+package myanimelist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAnime_DetailsMany(t *testing.T) {
+	var requests, inFlight, maxInFlight int32
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+
+		if r.URL.Query().Get("fields") != "mean" {
+			t.Errorf("request without fields: %s", r.URL)
+		}
+		id, _ := strconv.Atoi(path.Base(r.URL.Path))
+		switch id {
+		case 404:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not_found","message":""}`))
+		case 429:
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte("Too Many Requests"))
+		default:
+			_, _ = fmt.Fprintf(w, `{"id":%d,"title":"Anime %d","mean":8.5}`, id, id)
+		}
+	}))
+
+	ids := []int{1, 2, 404, 3, 429, 4, 5, 2}
+	batch, err := mal.Anime.DetailsMany(context.Background(), ids, BatchSettings{Concurrency: 2}, FieldMean)
+	if err != nil {
+		t.Fatalf("DetailsMany() error = %v", err)
+	}
+
+	if len(batch.Results) != 5 {
+		t.Errorf("DetailsMany() fetched %d entries, want 5", len(batch.Results))
+	}
+	if d := batch.Results[3]; d == nil || d.ID != 3 || d.Mean != 8.5 {
+		t.Errorf("DetailsMany() result of 3 = %+v", d)
+	}
+	if err := batch.Errors[404]; !errors.Is(err, ErrNotFound) {
+		t.Errorf("error of 404 = %v, want ErrNotFound", err)
+	}
+	if err := batch.Errors[429]; !errors.Is(err, ErrRateLimited) {
+		t.Errorf("error of 429 = %v, want ErrRateLimited", err)
+	}
+	if len(batch.Failed()) != 2 {
+		t.Errorf("Failed() = %v", batch.Failed())
+	}
+	if requests != 7 {
+		t.Errorf("made %d requests for 7 unique IDs", requests)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("made %d concurrent requests, want at most 2", maxInFlight)
+	}
+
+	if _, err := mal.Manga.DetailsMany(context.Background(), ids, BatchSettings{}, FieldNumEpisodes); err == nil {
+		t.Error("DetailsMany() accepted anime-only field")
+	}
+}
+
+func TestDetailsMany_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fetch := func(ctx context.Context, id int) (int, error) {
+		if id == 3 {
+			cancel()
+		}
+		return id, ctx.Err()
+	}
+	ids := make([]int, 100)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	batch, err := detailsMany(ctx, ids, BatchSettings{Concurrency: 1}, fetch)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("detailsMany() error = %v, want context.Canceled", err)
+	}
+	if len(batch.Results) != 2 || len(batch.Errors) != len(ids)-2 {
+		t.Errorf("detailsMany() = %d results and %d errors after cancellation", len(batch.Results), len(batch.Errors))
+	}
+	for id, err := range batch.Errors {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Errors[%d] = %v, want context.Canceled", id, err)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package myanimelist
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JST is Japan Standard Time, time zone of Broadcast.
+var JST = time.FixedZone("JST", 9*60*60)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Weekday returns day of broadcast. Returns false for irregular broadcasts ("other") and unknown days.
+func (b Broadcast) Weekday() (time.Weekday, bool) {
+	d, ok := weekdays[strings.ToLower(b.DayOfTheWeek)]
+	return d, ok
+}
+
+// Clock returns hour and minute of broadcast in JST.
+// Late-night broadcasts may be written as "25:30", which is 01:30 of the next day.
+func (b Broadcast) Clock() (hour int, minute int, ok bool) {
+	parts := strings.Split(b.StartTime, ":")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil || hour < 0 || hour > 47 {
+		return 0, 0, false
+	}
+	minute, err = strconv.Atoi(parts[1])
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, 0, false
+	}
+	return hour, minute, true
+}
+
+// Next returns first broadcast time at or after t, in t's location.
+// Returns false if broadcast day or time is unknown.
+func (b Broadcast) Next(t time.Time) (time.Time, bool) {
+	day, ok := b.Weekday()
+	if !ok {
+		return time.Time{}, false
+	}
+	hour, minute, ok := b.Clock()
+	if !ok {
+		return time.Time{}, false
+	}
+
+	tj := t.In(JST)
+	midnight := time.Date(tj.Year(), tj.Month(), tj.Day(), 0, 0, 0, 0, JST)
+	// start a week earlier, so slots past midnight ("25:30") of previous day aren't skipped
+	days := (int(day)-int(midnight.Weekday())+7)%7 - 7
+	slot := time.Date(midnight.Year(), midnight.Month(), midnight.Day()+days, hour, minute, 0, 0, JST)
+	for slot.Before(t) {
+		slot = slot.AddDate(0, 0, 7)
+	}
+	return slot.In(t.Location()), true
+}
+
+// Airing is single broadcast of anime's episode.
+type Airing struct {
+	Anime *AnimeDetails
+	// Episode is episode's number, or 0 if it can't be known, because exact start date is unknown
+	Episode int
+	Time    time.Time
+}
+
+// Airings returns broadcasts of anime within [from, to), in loc.
+// Schedule is built from Broadcast, StartDate, EndDate and NumEpisodes fields, so request them.
+// Breaks in broadcasting aren't known to API, so schedule assumes weekly airing without gaps.
+// Finished anime and anime without regular broadcast have no airings.
+func (a *AnimeDetails) Airings(from, to time.Time, loc *time.Location) []Airing {
+	if a.Status == AiringFinished {
+		return nil
+	}
+
+	// first possible episode
+	start := a.StartDate.Start()
+	exact := a.StartDate.Precision == PrecisionDay
+	if !a.StartDate.IsZero() {
+		start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, JST)
+	}
+	first, ok := a.Broadcast.Next(start)
+	if !ok {
+		return nil
+	}
+
+	// end of broadcasting, if known
+	var end time.Time
+	if !a.EndDate.IsZero() {
+		e := a.EndDate.End()
+		end = time.Date(e.Year(), e.Month(), e.Day(), 0, 0, 0, 0, JST)
+		// late-night slot ("25:30") of the last day airs after its midnight
+		if hour, minute, ok := a.Broadcast.Clock(); ok && hour >= 24 {
+			end = end.Add(time.Duration(hour-24)*time.Hour + time.Duration(minute+1)*time.Minute)
+		}
+	}
+	if exact && a.NumEpisodes > 0 {
+		last := first.AddDate(0, 0, 7*(a.NumEpisodes-1)).Add(time.Minute)
+		if end.IsZero() || last.Before(end) {
+			end = last
+		}
+	}
+	if !end.IsZero() && end.Before(to) {
+		to = end
+	}
+	if !a.StartDate.IsZero() && first.After(from) {
+		from = first
+	}
+
+	var airings []Airing
+	for slot, ok := a.Broadcast.Next(from); ok && slot.Before(to); slot = slot.AddDate(0, 0, 7) {
+		airing := Airing{Anime: a, Time: slot.In(loc)}
+		if exact {
+			airing.Episode = int(slot.Sub(first).Hours()/24/7+0.5) + 1
+		}
+		airings = append(airings, airing)
+	}
+	return airings
+}
+
+// NextAiring returns first broadcast of anime after t, in loc.
+// Returns false if there are no more broadcasts or they can't be known. See Airings.
+func (a *AnimeDetails) NextAiring(t time.Time, loc *time.Location) (Airing, bool) {
+	// weekly broadcast always airs within a week, if it airs at all
+	airings := a.Airings(t, t.AddDate(0, 0, 8), loc)
+	if len(airings) == 0 {
+		return Airing{}, false
+	}
+	return airings[0], true
+}
+
+// WeeklySchedule returns broadcasts of all anime within week since from, in loc, ordered by time.
+func WeeklySchedule(anime []*AnimeDetails, from time.Time, loc *time.Location) []Airing {
+	var schedule []Airing
+	for _, a := range anime {
+		schedule = append(schedule, a.Airings(from, from.AddDate(0, 0, 7), loc)...)
+	}
+	sort.SliceStable(schedule, func(i, j int) bool { return schedule[i].Time.Before(schedule[j].Time) })
+	return schedule
+}
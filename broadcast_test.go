@@ -0,0 +1,138 @@
+package myanimelist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBroadcast_Next(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no time zone database:", err)
+	}
+
+	tests := []struct {
+		name      string
+		broadcast Broadcast
+		after     time.Time
+		want      time.Time
+	}{
+		{
+			name:      "Same week",
+			broadcast: Broadcast{DayOfTheWeek: "saturday", StartTime: "23:30"},
+			after:     time.Date(2021, 4, 5, 0, 0, 0, 0, JST), // monday
+			want:      time.Date(2021, 4, 10, 23, 30, 0, 0, JST),
+		},
+		{
+			name:      "Shift to previous day in New York",
+			broadcast: Broadcast{DayOfTheWeek: "sunday", StartTime: "01:05"},
+			after:     time.Date(2021, 4, 5, 0, 0, 0, 0, newYork),
+			want:      time.Date(2021, 4, 10, 12, 5, 0, 0, newYork), // saturday
+		},
+		{
+			name:      "After midnight notation",
+			broadcast: Broadcast{DayOfTheWeek: "monday", StartTime: "25:30"},
+			after:     time.Date(2021, 4, 6, 0, 30, 0, 0, JST), // tuesday, before the slot
+			want:      time.Date(2021, 4, 6, 1, 30, 0, 0, JST),
+		},
+		{
+			name:      "Exactly at slot",
+			broadcast: Broadcast{DayOfTheWeek: "friday", StartTime: "17:00"},
+			after:     time.Date(2021, 4, 9, 17, 0, 0, 0, JST),
+			want:      time.Date(2021, 4, 9, 17, 0, 0, 0, JST),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.broadcast.Next(tt.after)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, %v, want %v", got, ok, tt.want)
+			}
+			if got.Location() != tt.after.Location() {
+				t.Errorf("Next() location = %v, want %v", got.Location(), tt.after.Location())
+			}
+		})
+	}
+
+	if _, ok := (Broadcast{DayOfTheWeek: "other"}).Next(time.Now()); ok {
+		t.Error("Next() resolved irregular broadcast")
+	}
+}
+
+func TestAnimeDetails_Airings(t *testing.T) {
+	anime := &AnimeDetails{
+		ID:          1,
+		Status:      AiringCurrently,
+		StartDate:   NewPartialDate(2021, 4, 3),
+		NumEpisodes: 3,
+		Broadcast:   Broadcast{DayOfTheWeek: "saturday", StartTime: "23:30"},
+	}
+
+	airings := anime.Airings(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	if len(airings) != 3 {
+		t.Fatalf("Airings() returned %d airings, want 3", len(airings))
+	}
+	for i, a := range airings {
+		want := time.Date(2021, 4, 3+7*i, 14, 30, 0, 0, time.UTC)
+		if a.Episode != i+1 || !a.Time.Equal(want) {
+			t.Errorf("airing %d = episode %d at %v, want episode %d at %v", i, a.Episode, a.Time, i+1, want)
+		}
+	}
+
+	next, ok := anime.NextAiring(time.Date(2021, 4, 5, 0, 0, 0, 0, time.UTC), time.UTC)
+	if !ok || next.Episode != 2 {
+		t.Errorf("NextAiring() = %+v, %v, want episode 2", next, ok)
+	}
+	if _, ok := anime.NextAiring(time.Date(2021, 4, 18, 0, 0, 0, 0, time.UTC), time.UTC); ok {
+		t.Error("NextAiring() after last episode")
+	}
+}
+
+func TestAnimeDetails_Airings_LateNight(t *testing.T) {
+	anime := &AnimeDetails{
+		ID:          1,
+		Status:      AiringCurrently,
+		StartDate:   NewPartialDate(2024, 1, 6),
+		EndDate:     NewPartialDate(2024, 3, 23),
+		NumEpisodes: 12,
+		Broadcast:   Broadcast{DayOfTheWeek: "saturday", StartTime: "25:30"},
+	}
+
+	airings := anime.Airings(time.Date(2024, 1, 1, 0, 0, 0, 0, JST), time.Date(2024, 6, 1, 0, 0, 0, 0, JST), JST)
+	if len(airings) != 12 {
+		t.Fatalf("Airings() returned %d airings, want 12", len(airings))
+	}
+	// the last episode airs on sunday's night, after EndDate
+	want := time.Date(2024, 3, 24, 1, 30, 0, 0, JST)
+	if last := airings[11]; last.Episode != 12 || !last.Time.Equal(want) {
+		t.Errorf("last airing = episode %d at %v, want episode 12 at %v", last.Episode, last.Time, want)
+	}
+
+	// end date alone is enough
+	anime.NumEpisodes = 0
+	if got := len(anime.Airings(time.Date(2024, 1, 1, 0, 0, 0, 0, JST), time.Date(2024, 6, 1, 0, 0, 0, 0, JST), JST)); got != 12 {
+		t.Errorf("Airings() without NumEpisodes returned %d airings, want 12", got)
+	}
+}
+
+func TestWeeklySchedule(t *testing.T) {
+	anime := []*AnimeDetails{
+		{ID: 1, Broadcast: Broadcast{DayOfTheWeek: "sunday", StartTime: "09:00"}},
+		{ID: 2, Broadcast: Broadcast{DayOfTheWeek: "monday", StartTime: "00:30"}},
+		{ID: 3, Broadcast: Broadcast{DayOfTheWeek: "saturday", StartTime: "18:00"}},
+		{ID: 4, Broadcast: Broadcast{DayOfTheWeek: "other"}},
+		{ID: 5, Status: AiringFinished, Broadcast: Broadcast{DayOfTheWeek: "monday", StartTime: "12:00"}},
+	}
+
+	from := time.Date(2021, 4, 5, 0, 0, 0, 0, JST) // monday
+	schedule := WeeklySchedule(anime, from, JST)
+	want := []int{2, 3, 1}
+	if len(schedule) != len(want) {
+		t.Fatalf("WeeklySchedule() returned %d airings, want %d", len(schedule), len(want))
+	}
+	for i, a := range schedule {
+		if a.Anime.ID != want[i] || a.Episode != 0 {
+			t.Errorf("schedule[%d] = anime %d episode %d, want anime %d", i, a.Anime.ID, a.Episode, want[i])
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package myanimelist
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// calendarFields are fields, required to build airing schedule.
+var calendarFields = []Field{FieldTitle, FieldStatus, FieldStartDate, FieldEndDate,
+	FieldNumEpisodes, FieldBroadcast, FieldAverageEpisodeDuration}
+
+// CalendarSettings controls iCalendar export.
+type CalendarSettings struct {
+	// Name is calendar's name, shown by calendar applications
+	Name string
+	// From is moment of export. Default is current time
+	From time.Time
+	// Horizon limits how far ahead episodes of anime with unknown length are exported.
+	// Default is 4 weeks
+	Horizon time.Duration
+}
+
+// WriteCalendar writes airing schedule of anime as iCalendar (RFC 5545) feed.
+// Anime with known start date and number of episodes becomes single weekly recurring event,
+// which ends after the last episode. Episodes of other anime become separate events within Horizon.
+// UIDs of events depend only on anime and episode, so calendar applications update them
+// instead of duplicating on re-import.
+// Anime must contain calendarFields, see Airings for details.
+func WriteCalendar(w io.Writer, anime []*AnimeDetails, settings CalendarSettings) error {
+	if settings.From.IsZero() {
+		settings.From = time.Now()
+	}
+	if settings.Horizon <= 0 {
+		settings.Horizon = 4 * 7 * 24 * time.Hour
+	}
+
+	cw := &calendarWriter{w: bufio.NewWriter(w)}
+	stamp := settings.From.UTC().Format(icalTime)
+	cw.line("BEGIN", "VCALENDAR")
+	cw.line("VERSION", "2.0")
+	cw.line("PRODID", "-//camelva//myanimelist-go//EN")
+	cw.line("CALSCALE", "GREGORIAN")
+	if settings.Name != "" {
+		cw.line("X-WR-CALNAME", icalEscape(settings.Name))
+	}
+
+	for _, a := range anime {
+		duration := time.Duration(a.AverageEpisodeDuration) * time.Second
+		if duration <= 0 {
+			duration = 24 * time.Minute
+		}
+		url := fmt.Sprintf("https://myanimelist.net/anime/%d", a.ID)
+
+		if a.StartDate.Precision == PrecisionDay && a.NumEpisodes > 0 {
+			airings := a.Airings(a.StartDate.Start().AddDate(0, 0, -1), maxTime, time.UTC)
+			if len(airings) == 0 || airings[0].Episode != 1 {
+				continue
+			}
+			cw.event(calendarEvent{
+				uid:      fmt.Sprintf("anime-%d@myanimelist-go", a.ID),
+				stamp:    stamp,
+				start:    airings[0].Time,
+				duration: duration,
+				summary:  a.Title,
+				url:      url,
+				rule:     fmt.Sprintf("FREQ=WEEKLY;COUNT=%d", len(airings)),
+			})
+			continue
+		}
+
+		for _, airing := range a.Airings(settings.From, settings.From.Add(settings.Horizon), time.UTC) {
+			e := calendarEvent{
+				uid:      fmt.Sprintf("anime-%d-%s@myanimelist-go", a.ID, airing.Time.Format("20060102")),
+				stamp:    stamp,
+				start:    airing.Time,
+				duration: duration,
+				summary:  a.Title,
+				url:      url,
+			}
+			if airing.Episode > 0 {
+				e.uid = fmt.Sprintf("anime-%d-ep%d@myanimelist-go", a.ID, airing.Episode)
+				e.summary = fmt.Sprintf("%s - Episode %d", a.Title, airing.Episode)
+			}
+			cw.event(e)
+		}
+	}
+
+	cw.line("END", "VCALENDAR")
+	if cw.err != nil {
+		return cw.err
+	}
+	return cw.w.Flush()
+}
+
+// WatchingCalendar writes airing schedule of anime, which user is watching, as iCalendar feed.
+// For current user use empty username. See WriteCalendar for details.
+func (al *AnimeList) WatchingCalendar(w io.Writer, username string, settings CalendarSettings) error {
+	list, err := al.User(username, StatusWatching, "", PagingSettings{Limit: 100}, calendarFields...)
+	if err != nil {
+		return err
+	}
+	entries, err := list.FetchAll(FetchAllSettings{})
+	if err != nil {
+		return err
+	}
+
+	anime := make([]*AnimeDetails, len(entries))
+	for i := range entries {
+		anime[i] = &entries[i].AnimeDetails
+	}
+	return WriteCalendar(w, anime, settings)
+}
+
+// Calendar writes airing schedule of anime with provided IDs as iCalendar feed.
+// Anime, which failed to load, are skipped and returned in Batch.Errors. See WriteCalendar for details.
+func (a *Anime) Calendar(ctx context.Context, w io.Writer, animeIDs []int, settings CalendarSettings) (*Batch[*AnimeDetails], error) {
+	batch, err := a.DetailsMany(ctx, animeIDs, BatchSettings{}, calendarFields...)
+	if err != nil {
+		return batch, err
+	}
+
+	// keep order of requested IDs, so output is stable
+	anime := make([]*AnimeDetails, 0, len(batch.Results))
+	seen := make(map[int]bool, len(animeIDs))
+	for _, id := range animeIDs {
+		if d, ok := batch.Results[id]; ok && !seen[id] {
+			seen[id] = true
+			anime = append(anime, d)
+		}
+	}
+	return batch, WriteCalendar(w, anime, settings)
+}
+
+const icalTime = "20060102T150405Z"
+
+// maxTime is far enough future for schedules of finite anime.
+var maxTime = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type calendarEvent struct {
+	uid      string
+	stamp    string
+	start    time.Time
+	duration time.Duration
+	summary  string
+	url      string
+	rule     string
+}
+
+// calendarWriter writes content lines, folding them at 75 octets, as RFC 5545 requires.
+// First write error is kept and stops further writes.
+type calendarWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (cw *calendarWriter) event(e calendarEvent) {
+	cw.line("BEGIN", "VEVENT")
+	cw.line("UID", e.uid)
+	cw.line("DTSTAMP", e.stamp)
+	cw.line("DTSTART", e.start.UTC().Format(icalTime))
+	cw.line("DURATION", fmt.Sprintf("PT%dM", int(e.duration.Minutes())))
+	if e.rule != "" {
+		cw.line("RRULE", e.rule)
+	}
+	cw.line("SUMMARY", icalEscape(e.summary))
+	cw.line("URL", e.url)
+	cw.line("END", "VEVENT")
+}
+
+func (cw *calendarWriter) line(name, value string) {
+	if cw.err != nil {
+		return
+	}
+	line := name + ":" + value
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+	_, cw.err = cw.w.WriteString(b.String())
+}
+
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`, "\r", "")
+
+func icalEscape(s string) string {
+	return icalEscaper.Replace(s)
+}
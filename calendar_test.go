@@ -0,0 +1,122 @@
+package myanimelist
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteCalendar(t *testing.T) {
+	anime := []*AnimeDetails{
+		{
+			ID:                     1,
+			Title:                  "Finite, with; special chars",
+			Status:                 AiringCurrently,
+			StartDate:              NewPartialDate(2021, 4, 3),
+			NumEpisodes:            12,
+			AverageEpisodeDuration: 1440,
+			Broadcast:              Broadcast{DayOfTheWeek: "saturday", StartTime: "23:30"},
+		},
+		{
+			ID:        2,
+			Title:     "Long-running",
+			Status:    AiringCurrently,
+			StartDate: NewPartialDate(1999, 10, 20),
+			Broadcast: Broadcast{DayOfTheWeek: "sunday", StartTime: "09:30"},
+		},
+		{
+			ID:        3,
+			Title:     strings.Repeat("Very long title ", 10),
+			Status:    AiringCurrently,
+			StartDate: NewPartialDate(2021, 0, 0),
+			Broadcast: Broadcast{DayOfTheWeek: "monday", StartTime: "12:00"},
+		},
+		{ID: 4, Title: "Finished", Status: AiringFinished, Broadcast: Broadcast{DayOfTheWeek: "monday", StartTime: "12:00"}},
+	}
+
+	var buf bytes.Buffer
+	settings := CalendarSettings{
+		Name:    "Watching",
+		From:    time.Date(2021, 4, 5, 0, 0, 0, 0, time.UTC),
+		Horizon: 14 * 24 * time.Hour,
+	}
+	if err := WriteCalendar(&buf, anime, settings); err != nil {
+		t.Fatalf("WriteCalendar() error = %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-CALNAME:Watching\r\n",
+		// finite anime is single recurring event
+		"UID:anime-1@myanimelist-go\r\nDTSTAMP:20210405T000000Z\r\nDTSTART:20210403T143000Z\r\nDURATION:PT24M\r\nRRULE:FREQ=WEEKLY;COUNT=12\r\n",
+		`SUMMARY:Finite\, with\; special chars`,
+		// episodes of long-running anime are separate events with numbers
+		"UID:anime-2-ep1121@myanimelist-go\r\n",
+		"SUMMARY:Long-running - Episode 1122\r\n",
+		// without exact start date episodes are identified by date
+		"UID:anime-3-20210405@myanimelist-go\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("calendar doesn't contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "BEGIN:VEVENT") != 5 {
+		t.Errorf("calendar contains %d events, want 5", strings.Count(out, "BEGIN:VEVENT"))
+	}
+	if strings.Contains(out, "anime-4") {
+		t.Error("calendar contains finished anime")
+	}
+	for _, line := range strings.Split(out, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line isn't folded: %q", line)
+		}
+	}
+}
+
+func TestWriteCalendar_LateNight(t *testing.T) {
+	anime := []*AnimeDetails{{
+		ID:          1,
+		Title:       "Late-night",
+		Status:      AiringCurrently,
+		StartDate:   NewPartialDate(2024, 1, 6),
+		EndDate:     NewPartialDate(2024, 3, 23),
+		NumEpisodes: 12,
+		Broadcast:   Broadcast{DayOfTheWeek: "saturday", StartTime: "25:30"},
+	}}
+
+	var buf bytes.Buffer
+	settings := CalendarSettings{From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if err := WriteCalendar(&buf, anime, settings); err != nil {
+		t.Fatalf("WriteCalendar() error = %v", err)
+	}
+	// 25:30 JST of saturday is 16:30 UTC of the same saturday
+	want := "DTSTART:20240106T163000Z\r\nDURATION:PT24M\r\nRRULE:FREQ=WEEKLY;COUNT=12\r\n"
+	if out := buf.String(); !strings.Contains(out, want) {
+		t.Errorf("calendar doesn't contain %q:\n%s", want, out)
+	}
+}
+
+func TestAnimeList_WatchingCalendar(t *testing.T) {
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("status") != string(StatusWatching) || !strings.Contains(query.Get("fields"), "broadcast") {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"node":{"id":7,"title":"Show","status":"currently_airing",
+			"start_date":"2021-04-03","num_episodes":2,"broadcast":{"day_of_the_week":"saturday","start_time":"23:30"}},
+			"list_status":{"status":"watching"}}],"paging":{}}`))
+	}))
+
+	var buf bytes.Buffer
+	if err := mal.Anime.List.WatchingCalendar(&buf, "", CalendarSettings{}); err != nil {
+		t.Fatalf("WatchingCalendar() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "UID:anime-7@myanimelist-go\r\n") ||
+		!strings.Contains(buf.String(), "RRULE:FREQ=WEEKLY;COUNT=2\r\n") {
+		t.Errorf("WatchingCalendar() wrote:\n%s", buf.String())
+	}
+}
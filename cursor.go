@@ -0,0 +1,272 @@
+package myanimelist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// Cursor is serializable position inside paged result.
+// Use Cursor() method of any paged result to get cursor of its page,
+// save it anywhere and later continue from it with corresponding Resume method,
+// such as Anime.ResumeTop() or Forum.ResumeSearch().
+type Cursor struct {
+	// Endpoint is API method, relative to API host. For example "anime/ranking"
+	Endpoint string `json:"endpoint"`
+	// Query contains request parameters, except limit and offset
+	Query  url.Values `json:"query,omitempty"`
+	Offset int        `json:"offset"`
+	Limit  int        `json:"limit,omitempty"`
+}
+
+// Cursor returns cursor of current page.
+func (l *listing) Cursor() Cursor {
+	query := url.Values{}
+	for k, v := range l.request.query {
+		query[k] = append([]string(nil), v...)
+	}
+	return Cursor{
+		Endpoint: l.request.endpoint,
+		Query:    query,
+		Offset:   l.request.offset,
+		Limit:    l.request.limit,
+	}
+}
+
+func (c Cursor) request() pageRequest {
+	return pageRequest{
+		endpoint: c.Endpoint,
+		query:    c.Query,
+		limit:    c.Limit,
+		offset:   c.Offset,
+	}
+}
+
+// check makes sure cursor points to API method, matching pattern.
+func (c Cursor) check(pattern string) error {
+	if ok, _ := path.Match(pattern, c.Endpoint); !ok {
+		return fmt.Errorf("cursor of %q can't be resumed here", c.Endpoint)
+	}
+	if c.Offset < 0 || c.Limit < 0 {
+		return errors.New("cursor with negative offset or limit")
+	}
+	return nil
+}
+
+// Listing is paged result with entries of type T.
+// It's implemented by every paged result, such as AnimeTop or ForumSearchResult.
+type Listing[T any] interface {
+	page[T]
+}
+
+// CursorStore keeps cursor of paged result between program runs.
+type CursorStore interface {
+	// SaveCursor replaces saved cursor.
+	SaveCursor(c Cursor) error
+	// LoadCursor returns saved cursor. If there is nothing saved, returns false.
+	LoadCursor() (Cursor, bool, error)
+	// ClearCursor removes saved cursor.
+	ClearCursor() error
+}
+
+// FileCursorStore keeps cursor in JSON file.
+type FileCursorStore struct {
+	Path string
+}
+
+func (s FileCursorStore) SaveCursor(c Cursor) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	// write to temporary file first, so crash can't leave broken cursor
+	tmp, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+func (s FileCursorStore) LoadCursor() (Cursor, bool, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return Cursor{}, false, nil
+	}
+	if err != nil {
+		return Cursor{}, false, err
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return Cursor{}, false, err
+	}
+	return c, true, nil
+}
+
+func (s FileCursorStore) ClearCursor() error {
+	if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Checkpoint walks over current and all next pages of listing, calling handle with entries of every page.
+// After each handled page cursor of the next one is saved into store, so if program crashed -
+// walk can be continued from saved cursor with corresponding Resume method.
+// When walk finished, saved cursor is cleared.
+func Checkpoint[T any](ctx context.Context, l Listing[T], store CursorStore, handle func(entries []T) error) error {
+	var current page[T] = l
+	for {
+		if err := handle(current.Items()); err != nil {
+			return err
+		}
+		if !current.HasNext() {
+			return store.ClearCursor()
+		}
+
+		next, err := nextPageContext(ctx, current)
+		if err != nil {
+			return err
+		}
+		if err := store.SaveCursor(next.Cursor()); err != nil {
+			return err
+		}
+		current = next
+	}
+}
+
+// ResumeSearch continues anime search from cursor, returned by AnimeSearchResult.Cursor().
+func (a *Anime) ResumeSearch(c Cursor) (*AnimeSearchResult, error) {
+	if err := c.check("anime"); err != nil {
+		return nil, err
+	}
+	result := &AnimeSearchResult{parent: a}
+	if err := a.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeTop continues anime ranking from cursor, returned by AnimeTop.Cursor().
+func (a *Anime) ResumeTop(c Cursor) (*AnimeTop, error) {
+	if err := c.check("anime/ranking"); err != nil {
+		return nil, err
+	}
+	result := &AnimeTop{parent: a}
+	if err := a.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeSeasonal continues seasonal anime list from cursor, returned by AnimeSeasonal.Cursor().
+func (a *Anime) ResumeSeasonal(c Cursor) (*AnimeSeasonal, error) {
+	if err := c.check("anime/season/*/*"); err != nil {
+		return nil, err
+	}
+	result := &AnimeSeasonal{parent: a}
+	if err := a.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeSuggestions continues anime suggestions from cursor, returned by AnimeSuggestions.Cursor().
+func (a *Anime) ResumeSuggestions(c Cursor) (*AnimeSuggestions, error) {
+	if err := c.check("anime/suggestions"); err != nil {
+		return nil, err
+	}
+	result := &AnimeSuggestions{parent: a}
+	if err := a.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeSearch continues manga search from cursor, returned by MangaSearchResult.Cursor().
+func (m *Manga) ResumeSearch(c Cursor) (*MangaSearchResult, error) {
+	if err := c.check("manga"); err != nil {
+		return nil, err
+	}
+	result := &MangaSearchResult{parent: m}
+	if err := m.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeTop continues manga ranking from cursor, returned by MangaTop.Cursor().
+func (m *Manga) ResumeTop(c Cursor) (*MangaTop, error) {
+	if err := c.check("manga/ranking"); err != nil {
+		return nil, err
+	}
+	result := &MangaTop{parent: m}
+	if err := m.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeUser continues user's anime list from cursor, returned by UserAnimeList.Cursor().
+func (al *AnimeList) ResumeUser(c Cursor) (*UserAnimeList, error) {
+	if err := c.check("users/*/animelist"); err != nil {
+		return nil, err
+	}
+	result := &UserAnimeList{parent: al}
+	if err := al.anime.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeUser continues user's manga list from cursor, returned by UserMangaList.Cursor().
+func (ml *MangaList) ResumeUser(c Cursor) (*UserMangaList, error) {
+	if err := c.check("users/*/mangalist"); err != nil {
+		return nil, err
+	}
+	result := &UserMangaList{parent: ml}
+	if err := ml.manga.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeTopic continues topic's posts from cursor, returned by ForumTopic.Cursor().
+func (f *Forum) ResumeTopic(c Cursor) (*ForumTopic, error) {
+	if err := c.check("forum/topic/*"); err != nil {
+		return nil, err
+	}
+	result := &ForumTopic{parent: f}
+	if err := f.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResumeSearch continues forum search from cursor, returned by ForumSearchResult.Cursor().
+func (f *Forum) ResumeSearch(c Cursor) (*ForumSearchResult, error) {
+	if err := c.check("forum/topics"); err != nil {
+		return nil, err
+	}
+	result := &ForumSearchResult{parent: f}
+	if err := f.mal.requestPage(context.Background(), result, c.request()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+package myanimelist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestCursor_Resume(t *testing.T) {
+	mal := newFakeMAL(t, fakeListing(30))
+
+	first, err := mal.Anime.Top(RankAiring, PagingSettings{Limit: 10})
+	if err != nil {
+		t.Fatalf("Top() error = %v", err)
+	}
+	second, err := first.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+
+	data, err := json.Marshal(second.Cursor())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		t.Fatal(err)
+	}
+	if cursor.Endpoint != "anime/ranking" || cursor.Offset != 10 || cursor.Limit != 10 ||
+		cursor.Query.Get("ranking_type") != RankAiring {
+		t.Errorf("Cursor() = %+v", cursor)
+	}
+
+	resumed, err := mal.Anime.ResumeTop(cursor)
+	if err != nil {
+		t.Fatalf("ResumeTop() error = %v", err)
+	}
+	if len(resumed.Data) != 10 || resumed.Data[0].ID != 11 {
+		t.Errorf("ResumeTop() resumed at wrong page: %+v", resumed.Data)
+	}
+	if _, err := resumed.Next(); err != nil {
+		t.Errorf("Next() of resumed page error = %v", err)
+	}
+
+	if _, err := mal.Manga.ResumeTop(cursor); err == nil {
+		t.Error("Manga.ResumeTop() accepted cursor of anime ranking")
+	}
+	if _, err := mal.Forum.ResumeSearch(Cursor{Endpoint: "https://example.com/forum/topics"}); err == nil {
+		t.Error("Forum.ResumeSearch() accepted cursor with foreign host")
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	mal := newFakeMAL(t, fakeListing(45))
+	store := FileCursorStore{Path: filepath.Join(t.TempDir(), "cursor.json")}
+	errCrash := errors.New("crash")
+
+	var seen []int
+	handle := func(crashAt int) func(entries []UserMangaListEntry) error {
+		return func(entries []UserMangaListEntry) error {
+			for _, e := range entries {
+				if e.ID == crashAt {
+					return errCrash
+				}
+				seen = append(seen, e.ID)
+			}
+			return nil
+		}
+	}
+
+	list, err := mal.Manga.List.User("someone", "", "", PagingSettings{Limit: 10})
+	if err != nil {
+		t.Fatalf("User() error = %v", err)
+	}
+	if err := Checkpoint[UserMangaListEntry](context.Background(), list, store, handle(25)); !errors.Is(err, errCrash) {
+		t.Fatalf("Checkpoint() error = %v, want crash", err)
+	}
+
+	cursor, ok, err := store.LoadCursor()
+	if err != nil || !ok {
+		t.Fatalf("LoadCursor() = %v, %v", ok, err)
+	}
+	if cursor.Offset != 20 {
+		t.Errorf("saved cursor offset = %d, want 20", cursor.Offset)
+	}
+
+	// continue after "restart", re-handling interrupted page
+	seen = seen[:20]
+	resumed, err := mal.Manga.List.ResumeUser(cursor)
+	if err != nil {
+		t.Fatalf("ResumeUser() error = %v", err)
+	}
+	if err := Checkpoint[UserMangaListEntry](context.Background(), resumed, store, handle(-1)); err != nil {
+		t.Fatalf("Checkpoint() error = %v", err)
+	}
+	if len(seen) != 45 || seen[44] != 45 {
+		t.Errorf("handled %d entries, want 45", len(seen))
+	}
+	if _, ok, _ := store.LoadCursor(); ok {
+		t.Error("cursor left in store after finished walk")
+	}
+}
@@ -0,0 +1,193 @@
+package myanimelist
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DatePrecision tells which parts of PartialDate are known.
+type DatePrecision int
+
+const (
+	// PrecisionNone is precision of empty date
+	PrecisionNone DatePrecision = iota
+	// PrecisionYear means only year is known: "2009"
+	PrecisionYear
+	// PrecisionMonth means year and month are known: "2009-04"
+	PrecisionMonth
+	// PrecisionDay is complete date: "2009-04-05"
+	PrecisionDay
+)
+
+// PartialDate is date, which may be known only up to year or month,
+// such as start and end dates of anime or manga.
+// Zero value is empty (unknown) date. Use IsZero to check it, as empty date decoded from JSON null
+// remembers it to be encoded back as null.
+type PartialDate struct {
+	Year      int
+	Month     time.Month
+	Day       int
+	Precision DatePrecision
+
+	null bool
+}
+
+// NewPartialDate creates date with precision, defined by first zero part.
+// For example NewPartialDate(2009, 4, 0) is "2009-04".
+func NewPartialDate(year int, month time.Month, day int) PartialDate {
+	switch {
+	case year == 0:
+		return PartialDate{}
+	case month == 0:
+		return PartialDate{Year: year, Precision: PrecisionYear}
+	case day == 0:
+		return PartialDate{Year: year, Month: month, Precision: PrecisionMonth}
+	}
+	return PartialDate{Year: year, Month: month, Day: day, Precision: PrecisionDay}
+}
+
+// PartialDateOf returns complete date of t.
+func PartialDateOf(t time.Time) PartialDate {
+	return NewPartialDate(t.Year(), t.Month(), t.Day())
+}
+
+// ParsePartialDate parses "YYYY", "YYYY-MM" or "YYYY-MM-DD". Empty string is empty date.
+func ParsePartialDate(s string) (PartialDate, error) {
+	if s == "" {
+		return PartialDate{}, nil
+	}
+
+	parts := strings.Split(s, "-")
+	if len(parts) > 3 {
+		return PartialDate{}, fmt.Errorf("invalid date %q", s)
+	}
+	nums := make([]int, 3)
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n <= 0 {
+			return PartialDate{}, fmt.Errorf("invalid date %q", s)
+		}
+		nums[i] = n
+	}
+
+	d := NewPartialDate(nums[0], time.Month(nums[1]), nums[2])
+	if d.Month > 12 || (d.Precision == PrecisionDay && d.Start().Day() != d.Day) {
+		return PartialDate{}, fmt.Errorf("invalid date %q", s)
+	}
+	return d, nil
+}
+
+// IsZero reports whether date is empty.
+func (d PartialDate) IsZero() bool {
+	return d.Precision == PrecisionNone
+}
+
+// String formats date as "YYYY", "YYYY-MM" or "YYYY-MM-DD", depending on precision.
+func (d PartialDate) String() string {
+	switch d.Precision {
+	case PrecisionYear:
+		return fmt.Sprintf("%04d", d.Year)
+	case PrecisionMonth:
+		return fmt.Sprintf("%04d-%02d", d.Year, d.Month)
+	case PrecisionDay:
+		return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+	}
+	return ""
+}
+
+// Start returns first moment of date in UTC. For empty date returns zero time.
+func (d PartialDate) Start() time.Time {
+	switch d.Precision {
+	case PrecisionYear:
+		return time.Date(d.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	case PrecisionMonth:
+		return time.Date(d.Year, d.Month, 1, 0, 0, 0, 0, time.UTC)
+	case PrecisionDay:
+		return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Time{}
+}
+
+// End returns first moment after date in UTC, so date covers [Start, End).
+// For empty date returns zero time.
+func (d PartialDate) End() time.Time {
+	switch d.Precision {
+	case PrecisionYear:
+		return d.Start().AddDate(1, 0, 0)
+	case PrecisionMonth:
+		return d.Start().AddDate(0, 1, 0)
+	case PrecisionDay:
+		return d.Start().AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+// Contains checks whether t is within date.
+func (d PartialDate) Contains(t time.Time) bool {
+	return !d.IsZero() && !t.Before(d.Start()) && t.Before(d.End())
+}
+
+// Compare returns -1, 0 or 1 if d is before, equal or after other.
+// Less precise date goes before more precise one within it, so "2009" < "2009-04" < "2009-04-05".
+// Empty date goes before everything.
+func (d PartialDate) Compare(other PartialDate) int {
+	a, b := d.Start(), other.Start()
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	case d.Precision < other.Precision:
+		return -1
+	case d.Precision > other.Precision:
+		return 1
+	}
+	return 0
+}
+
+// Before reports whether d goes before other, see Compare.
+func (d PartialDate) Before(other PartialDate) bool {
+	return d.Compare(other) < 0
+}
+
+// MarshalText encodes date in API's format. Empty date is encoded as empty string.
+func (d PartialDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes date in API's format.
+func (d *PartialDate) UnmarshalText(data []byte) error {
+	parsed, err := ParsePartialDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+var jsonNull = []byte("null")
+
+// MarshalJSON encodes date as JSON string, see MarshalText.
+// Empty date, decoded from null, is encoded as null again.
+func (d PartialDate) MarshalJSON() ([]byte, error) {
+	if d.null && d.IsZero() {
+		return jsonNull, nil
+	}
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes date from JSON string or null.
+func (d *PartialDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*d = PartialDate{null: true}
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return fmt.Errorf("invalid date %s", data)
+	}
+	return d.UnmarshalText([]byte(s))
+}
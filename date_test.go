@@ -0,0 +1,130 @@
+package myanimelist
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestParsePartialDate(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      PartialDate
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   bool
+	}{
+		{input: ""},
+		{
+			input:     "2009",
+			want:      PartialDate{Year: 2009, Precision: PrecisionYear},
+			wantStart: time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			input:     "2009-04",
+			want:      PartialDate{Year: 2009, Month: time.April, Precision: PrecisionMonth},
+			wantStart: time.Date(2009, 4, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2009, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			input:     "2009-04-05",
+			want:      PartialDate{Year: 2009, Month: time.April, Day: 5, Precision: PrecisionDay},
+			wantStart: time.Date(2009, 4, 5, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2009, 4, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{input: "2009-13", wantErr: true},
+		{input: "2009-02-30", wantErr: true},
+		{input: "2009-04-05-01", wantErr: true},
+		{input: "April 2009", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePartialDate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePartialDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParsePartialDate() = %+v, want %+v", got, tt.want)
+			}
+			if got.String() != tt.input {
+				t.Errorf("String() = %q, want %q", got.String(), tt.input)
+			}
+			if !got.Start().Equal(tt.wantStart) || !got.End().Equal(tt.wantEnd) {
+				t.Errorf("range = [%v, %v), want [%v, %v)", got.Start(), got.End(), tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestPartialDate_Compare(t *testing.T) {
+	dates := []PartialDate{
+		NewPartialDate(2009, 4, 5),
+		NewPartialDate(2008, 12, 31),
+		{},
+		NewPartialDate(2009, 4, 0),
+		NewPartialDate(2009, 0, 0),
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	want := []string{"", "2008-12-31", "2009", "2009-04", "2009-04-05"}
+	for i, d := range dates {
+		if d.String() != want[i] {
+			t.Errorf("sorted[%d] = %q, want %q", i, d, want[i])
+		}
+	}
+	if NewPartialDate(2009, 4, 0).Compare(NewPartialDate(2009, 4, 0)) != 0 {
+		t.Error("Compare() of equal dates != 0")
+	}
+	if !NewPartialDate(2009, 4, 0).Contains(time.Date(2009, 4, 30, 23, 0, 0, 0, time.UTC)) {
+		t.Error("Contains() = false for last day of month")
+	}
+}
+
+func TestPartialDate_JSON(t *testing.T) {
+	input := `{"start_date":"2009-04","end_date":"","status":"reading","finish_date":"2010"}`
+	var v struct {
+		StartDate  PartialDate `json:"start_date"`
+		EndDate    PartialDate `json:"end_date"`
+		Status     ListStatus  `json:"status"`
+		FinishDate PartialDate `json:"finish_date"`
+	}
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(out) != input {
+		t.Errorf("Marshal() = %s, want %s", out, input)
+	}
+
+	input = `{"start_date":null,"end_date":"2010-03-02"}`
+	var nullable struct {
+		StartDate PartialDate `json:"start_date"`
+		EndDate   PartialDate `json:"end_date"`
+	}
+	if err := json.Unmarshal([]byte(input), &nullable); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !nullable.StartDate.IsZero() {
+		t.Errorf("Unmarshal() start_date = %v, want empty date", nullable.StartDate)
+	}
+	out, err = json.Marshal(nullable)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(out) != input {
+		t.Errorf("Marshal() = %s, want %s", out, input)
+	}
+
+	c := NewAnimeConfig(1).SetStartDate(NewPartialDate(2021, 3, 0)).SetFinishDate(PartialDate{})
+	if c["start_date"] != "2021-03" || c["finish_date"] != "" {
+		t.Errorf("config = %v", c)
+	}
+}
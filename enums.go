@@ -0,0 +1,246 @@
+package myanimelist
+
+// All enum types below are plain strings, so values unknown to this library
+// are decoded and encoded back without changes. Label() of unknown value returns value itself.
+
+// MediaType is format of anime or manga.
+type MediaType string
+
+const (
+	MediaUnknown MediaType = "unknown"
+	// Anime media types
+	MediaTV      MediaType = "tv"
+	MediaOVA     MediaType = "ova"
+	MediaMovie   MediaType = "movie"
+	MediaSpecial MediaType = "special"
+	MediaONA     MediaType = "ona"
+	MediaMusic   MediaType = "music"
+	// Manga media types
+	MediaManga      MediaType = "manga"
+	MediaNovel      MediaType = "novel"
+	MediaLightNovel MediaType = "light_novel"
+	MediaOneShot    MediaType = "one_shot"
+	MediaDoujinshi  MediaType = "doujinshi"
+	MediaManhwa     MediaType = "manhwa"
+	MediaManhua     MediaType = "manhua"
+	MediaOEL        MediaType = "oel"
+)
+
+var mediaTypeLabels = map[MediaType]string{
+	MediaUnknown:    "Unknown",
+	MediaTV:         "TV",
+	MediaOVA:        "OVA",
+	MediaMovie:      "Movie",
+	MediaSpecial:    "Special",
+	MediaONA:        "ONA",
+	MediaMusic:      "Music",
+	MediaManga:      "Manga",
+	MediaNovel:      "Novel",
+	MediaLightNovel: "Light Novel",
+	MediaOneShot:    "One-shot",
+	MediaDoujinshi:  "Doujinshi",
+	MediaManhwa:     "Manhwa",
+	MediaManhua:     "Manhua",
+	MediaOEL:        "OEL",
+}
+
+func (t MediaType) String() string { return string(t) }
+
+// Label returns human-readable media type, such as "Light Novel".
+func (t MediaType) Label() string { return label(mediaTypeLabels, t) }
+
+// AiringStatus is status of anime.
+type AiringStatus string
+
+const (
+	AiringFinished  AiringStatus = "finished_airing"
+	AiringCurrently AiringStatus = "currently_airing"
+	AiringNotYet    AiringStatus = "not_yet_aired"
+)
+
+var airingStatusLabels = map[AiringStatus]string{
+	AiringFinished:  "Finished Airing",
+	AiringCurrently: "Currently Airing",
+	AiringNotYet:    "Not yet aired",
+}
+
+func (s AiringStatus) String() string { return string(s) }
+
+// Label returns human-readable status, such as "Currently Airing".
+func (s AiringStatus) Label() string { return label(airingStatusLabels, s) }
+
+// PublishingStatus is status of manga.
+type PublishingStatus string
+
+const (
+	PublishingFinished     PublishingStatus = "finished"
+	PublishingCurrently    PublishingStatus = "currently_publishing"
+	PublishingNotYet       PublishingStatus = "not_yet_published"
+	PublishingOnHiatus     PublishingStatus = "on_hiatus"
+	PublishingDiscontinued PublishingStatus = "discontinued"
+)
+
+var publishingStatusLabels = map[PublishingStatus]string{
+	PublishingFinished:     "Finished",
+	PublishingCurrently:    "Publishing",
+	PublishingNotYet:       "Not yet published",
+	PublishingOnHiatus:     "On Hiatus",
+	PublishingDiscontinued: "Discontinued",
+}
+
+func (s PublishingStatus) String() string { return string(s) }
+
+// Label returns human-readable status, such as "On Hiatus".
+func (s PublishingStatus) Label() string { return label(publishingStatusLabels, s) }
+
+// Rating is age rating of anime.
+type Rating string
+
+const (
+	RatingG     Rating = "g"
+	RatingPG    Rating = "pg"
+	RatingPG13  Rating = "pg_13"
+	RatingR     Rating = "r"
+	RatingRPlus Rating = "r+"
+	RatingRx    Rating = "rx"
+)
+
+var ratingLabels = map[Rating]string{
+	RatingG:     "G - All Ages",
+	RatingPG:    "PG - Children",
+	RatingPG13:  "PG-13 - Teens 13 or older",
+	RatingR:     "R - 17+ (violence & profanity)",
+	RatingRPlus: "R+ - Mild Nudity",
+	RatingRx:    "Rx - Hentai",
+}
+
+func (r Rating) String() string { return string(r) }
+
+// Label returns human-readable rating, such as "PG-13 - Teens 13 or older".
+func (r Rating) Label() string { return label(ratingLabels, r) }
+
+// Source is original work, anime is based on.
+type Source string
+
+const (
+	SourceOther        Source = "other"
+	SourceOriginal     Source = "original"
+	SourceManga        Source = "manga"
+	Source4KomaManga   Source = "4_koma_manga"
+	SourceWebManga     Source = "web_manga"
+	SourceDigitalManga Source = "digital_manga"
+	SourceNovel        Source = "novel"
+	SourceLightNovel   Source = "light_novel"
+	SourceVisualNovel  Source = "visual_novel"
+	SourceGame         Source = "game"
+	SourceCardGame     Source = "card_game"
+	SourceBook         Source = "book"
+	SourcePictureBook  Source = "picture_book"
+	SourceRadio        Source = "radio"
+	SourceMusic        Source = "music"
+	SourceWebNovel     Source = "web_novel"
+	SourceMixedMedia   Source = "mixed_media"
+)
+
+var sourceLabels = map[Source]string{
+	SourceOther:        "Other",
+	SourceOriginal:     "Original",
+	SourceManga:        "Manga",
+	Source4KomaManga:   "4-koma manga",
+	SourceWebManga:     "Web manga",
+	SourceDigitalManga: "Digital manga",
+	SourceNovel:        "Novel",
+	SourceLightNovel:   "Light novel",
+	SourceVisualNovel:  "Visual novel",
+	SourceGame:         "Game",
+	SourceCardGame:     "Card game",
+	SourceBook:         "Book",
+	SourcePictureBook:  "Picture book",
+	SourceRadio:        "Radio",
+	SourceMusic:        "Music",
+	SourceWebNovel:     "Web novel",
+	SourceMixedMedia:   "Mixed media",
+}
+
+func (s Source) String() string { return string(s) }
+
+// Label returns human-readable source, such as "Light novel".
+func (s Source) Label() string { return label(sourceLabels, s) }
+
+// NSFW is "not safe for work" level of anime or manga.
+type NSFW string
+
+const (
+	NSFWWhite NSFW = "white"
+	NSFWGray  NSFW = "gray"
+	NSFWBlack NSFW = "black"
+)
+
+var nsfwLabels = map[NSFW]string{
+	NSFWWhite: "Safe for work",
+	NSFWGray:  "May be not safe for work",
+	NSFWBlack: "Not safe for work",
+}
+
+func (n NSFW) String() string { return string(n) }
+
+// Label returns human-readable NSFW level, such as "Safe for work".
+func (n NSFW) Label() string { return label(nsfwLabels, n) }
+
+// SeasonName is name of year's season. See SeasonWinter and others.
+type SeasonName string
+
+var seasonLabels = map[SeasonName]string{
+	SeasonWinter: "Winter",
+	SeasonSpring: "Spring",
+	SeasonSummer: "Summer",
+	SeasonFall:   "Fall",
+}
+
+func (s SeasonName) String() string { return string(s) }
+
+// Label returns capitalized season name, such as "Spring".
+func (s SeasonName) Label() string { return label(seasonLabels, s) }
+
+// Valid checks whether s is one of four known seasons.
+func (s SeasonName) Valid() bool {
+	_, ok := seasonLabels[s]
+	return ok
+}
+
+// ListStatus is status of anime or manga in user's list. See StatusWatching and others.
+type ListStatus string
+
+var listStatusLabels = map[ListStatus]string{
+	StatusWatching:    "Watching",
+	StatusReading:     "Reading",
+	StatusCompleted:   "Completed",
+	StatusOnHold:      "On Hold",
+	StatusDropped:     "Dropped",
+	StatusPlanToWatch: "Plan to Watch",
+	StatusPlanToRead:  "Plan to Read",
+}
+
+func (s ListStatus) String() string { return string(s) }
+
+// Label returns human-readable status, such as "Plan to Watch".
+func (s ListStatus) Label() string { return label(listStatusLabels, s) }
+
+// IsAnime checks whether status is applicable to anime lists.
+func (s ListStatus) IsAnime() bool {
+	_, ok := makeList(append(generalStatuses, animeStatuses...))[s]
+	return ok
+}
+
+// IsManga checks whether status is applicable to manga lists.
+func (s ListStatus) IsManga() bool {
+	_, ok := makeList(append(generalStatuses, mangaStatuses...))[s]
+	return ok
+}
+
+func label[T ~string](labels map[T]string, v T) string {
+	if l, ok := labels[v]; ok {
+		return l
+	}
+	return string(v)
+}
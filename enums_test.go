@@ -0,0 +1,70 @@
+package myanimelist
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEnums_Label(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "Rating", got: RatingPG13.Label(), want: "PG-13 - Teens 13 or older"},
+		{name: "Media type", got: MediaLightNovel.Label(), want: "Light Novel"},
+		{name: "Airing status", got: AiringCurrently.Label(), want: "Currently Airing"},
+		{name: "Publishing status", got: PublishingOnHiatus.Label(), want: "On Hiatus"},
+		{name: "Source", got: Source4KomaManga.Label(), want: "4-koma manga"},
+		{name: "NSFW", got: NSFWWhite.Label(), want: "Safe for work"},
+		{name: "Season", got: SeasonFall.Label(), want: "Fall"},
+		{name: "List status", got: StatusPlanToWatch.Label(), want: "Plan to Watch"},
+		{name: "Unknown value", got: Rating("new_rating").Label(), want: "new_rating"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Label() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnums_JSON(t *testing.T) {
+	data := `{"media_type":"tv","status":"not_yet_aired","rating":"r+","source":"web_novel",` +
+		`"nsfw":"black","start_season":{"year":2022,"season":"summer"},"my_list_status":{"status":"rewatching_soon"}}`
+
+	var details AnimeDetails
+	if err := json.Unmarshal([]byte(data), &details); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if details.MediaType != MediaTV || details.Status != AiringNotYet || details.Rating != RatingRPlus ||
+		details.Source != SourceWebNovel || details.Nsfw != NSFWBlack || details.StartSeason.Season != SeasonSummer {
+		t.Errorf("Unmarshal() = %+v", details)
+	}
+
+	// unknown values must survive round-trip
+	out, err := json.Marshal(details)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(out), `"status":"rewatching_soon"`) {
+		t.Errorf("Marshal() lost unknown list status: %s", out)
+	}
+}
+
+func TestListStatus_Kind(t *testing.T) {
+	if !StatusWatching.IsAnime() || StatusWatching.IsManga() {
+		t.Error("StatusWatching must be anime-only")
+	}
+	if !StatusCompleted.IsAnime() || !StatusCompleted.IsManga() {
+		t.Error("StatusCompleted must be shared")
+	}
+	if ListStatus("unknown").IsAnime() {
+		t.Error("unknown status accepted")
+	}
+	if c := NewMangaConfig(1).SetStatus(StatusPlanToWatch); c["status"] != "" {
+		t.Errorf("SetStatus() accepted anime status for manga: %v", c)
+	}
+}
@@ -0,0 +1,368 @@
+package myanimelist
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Field is name of field to request, optionally with nested sub-fields.
+// All predefined Field* constants can be extended with sub-fields, for example:
+//
+//	FieldMyListStatus.With("status", "score") // my_list_status{status,score}
+//	FieldRelatedAnime.With(Field("node").With(FieldTitle, FieldMean)) // related_anime{node{title,mean}}
+type Field string
+
+// With returns copy of field with added sub-fields.
+func (f Field) With(sub ...Field) Field {
+	node, err := parseField(string(f))
+	if err != nil {
+		// keep broken field as is, so validation reports it
+		return f
+	}
+	for _, s := range sub {
+		subNode, err := parseField(string(s))
+		if err != nil {
+			return Field(fmt.Sprintf("%s{%s}", f, s))
+		}
+		node.add(subNode)
+	}
+	return Field(node.String())
+}
+
+// Name returns field's name without sub-fields.
+func (f Field) Name() string {
+	if i := strings.IndexByte(string(f), '{'); i >= 0 {
+		return strings.TrimSpace(string(f[:i]))
+	}
+	return strings.TrimSpace(string(f))
+}
+
+// Fields is set of requested fields.
+type Fields []Field
+
+// String serializes fields into format, accepted by API: "id,title,my_list_status{status,score}".
+// Duplicate fields are merged. Serialization doesn't validate fields, use Validate methods for this.
+func (fs Fields) String() string {
+	nodes, err := parseFields(fs)
+	if err != nil {
+		parts := make([]string, len(fs))
+		for i, f := range fs {
+			parts[i] = string(f)
+		}
+		return strings.Join(parts, ",")
+	}
+	return nodes.String()
+}
+
+// ValidateAnime checks, whether all fields are known anime fields.
+func (fs Fields) ValidateAnime() error {
+	_, err := fs.build(animeSchema, "anime")
+	return err
+}
+
+// ValidateManga checks, whether all fields are known manga fields.
+func (fs Fields) ValidateManga() error {
+	_, err := fs.build(mangaSchema, "manga")
+	return err
+}
+
+// build parses and validates fields against schema, expanding FieldAllAvailable.
+func (fs Fields) build(schema *fieldSchema, kind string) (fieldNodes, error) {
+	var expanded Fields
+	for _, f := range fs {
+		if strings.TrimSpace(string(f)) == string(FieldAllAvailable) {
+			expanded = append(expanded, schema.all...)
+			continue
+		}
+		expanded = append(expanded, f)
+	}
+
+	nodes, err := parseFields(expanded)
+	if err != nil {
+		return nil, err
+	}
+	if err := schema.validate(nodes, ""); err != nil {
+		return nil, fmt.Errorf("invalid %s field: %s", kind, err)
+	}
+	return nodes, nil
+}
+
+// setFields validates fields against schema and adds them to request parameters.
+// Nothing is added, if there are no fields.
+func setFields(data url.Values, fields []Field, schema *fieldSchema, kind string) error {
+	nodes, err := Fields(fields).build(schema, kind)
+	if err != nil {
+		return err
+	}
+	if len(nodes) > 0 {
+		data.Set("fields", nodes.String())
+	}
+	return nil
+}
+
+// fieldNode is parsed Field.
+type fieldNode struct {
+	name string
+	sub  fieldNodes
+}
+
+type fieldNodes []*fieldNode
+
+// add merges node into list of sub-fields.
+func (n *fieldNode) add(node *fieldNode) {
+	n.sub = n.sub.merge(node)
+}
+
+func (nodes fieldNodes) merge(node *fieldNode) fieldNodes {
+	for _, existing := range nodes {
+		if existing.name == node.name {
+			for _, s := range node.sub {
+				existing.add(s)
+			}
+			return nodes
+		}
+	}
+	return append(nodes, node)
+}
+
+func (n *fieldNode) String() string {
+	if len(n.sub) == 0 {
+		return n.name
+	}
+	return n.name + "{" + n.sub.String() + "}"
+}
+
+func (nodes fieldNodes) String() string {
+	parts := make([]string, len(nodes))
+	for i, n := range nodes {
+		parts[i] = n.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+func parseFields(fs Fields) (fieldNodes, error) {
+	var nodes fieldNodes
+	for _, f := range fs {
+		list, err := parseFieldList(string(f))
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range list {
+			nodes = nodes.merge(n)
+		}
+	}
+	return nodes, nil
+}
+
+// parseField parses single field with optional sub-fields.
+func parseField(s string) (*fieldNode, error) {
+	nodes, err := parseFieldList(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) != 1 {
+		return nil, fmt.Errorf("expected single field, got %q", s)
+	}
+	return nodes[0], nil
+}
+
+// parseFieldList parses comma-separated list of fields, such as "id,authors{first_name,last_name}".
+func parseFieldList(s string) (fieldNodes, error) {
+	p := &fieldParser{input: s}
+	nodes, err := p.list()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at position %d of %q", p.input[p.pos], p.pos, s)
+	}
+	return nodes, nil
+}
+
+type fieldParser struct {
+	input string
+	pos   int
+}
+
+func (p *fieldParser) list() (fieldNodes, error) {
+	var nodes fieldNodes
+	for {
+		node, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+		nodes = nodes.merge(node)
+
+		p.skipSpaces()
+		if p.pos < len(p.input) && p.input[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		return nodes, nil
+	}
+}
+
+func (p *fieldParser) field() (*fieldNode, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune("{},", rune(p.input[p.pos])) {
+		p.pos++
+	}
+	name := strings.TrimSpace(p.input[start:p.pos])
+	if name == "" {
+		return nil, fmt.Errorf("empty field name at position %d of %q", start, p.input)
+	}
+
+	node := &fieldNode{name: name}
+	if p.pos < len(p.input) && p.input[p.pos] == '{' {
+		p.pos++
+		sub, err := p.list()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.input) || p.input[p.pos] != '}' {
+			return nil, fmt.Errorf("unclosed sub-fields of %q in %q", name, p.input)
+		}
+		p.pos++
+		node.sub = sub
+	}
+	return node, nil
+}
+
+func (p *fieldParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// fieldSchema describes fields of certain object. Field without schema can't have sub-fields.
+type fieldSchema struct {
+	fields map[string]*fieldSchema
+	// all is list of top-level fields, used for FieldAllAvailable
+	all Fields
+}
+
+func (s *fieldSchema) validate(nodes fieldNodes, path string) error {
+	for _, n := range nodes {
+		fullName := n.name
+		if path != "" {
+			fullName = path + "." + n.name
+		}
+		sub, ok := s.fields[n.name]
+		if !ok {
+			return fmt.Errorf("unknown field %q, expected one of: %s", fullName, s.names())
+		}
+		if len(n.sub) == 0 {
+			continue
+		}
+		if sub == nil {
+			return fmt.Errorf("field %q has no sub-fields", fullName)
+		}
+		if err := sub.validate(n.sub, fullName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fieldSchema) names() string {
+	names := make([]string, 0, len(s.fields))
+	for name := range s.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// leafSchema creates schema of object with plain fields only.
+func leafSchema(names ...string) *fieldSchema {
+	s := &fieldSchema{fields: make(map[string]*fieldSchema, len(names))}
+	for _, name := range names {
+		s.fields[name] = nil
+	}
+	return s
+}
+
+var animeSchema, mangaSchema = buildSchemas()
+
+// Users' lists accept same fields as anime and manga, plus list_status of entry.
+var (
+	animeListSchema = withField(animeSchema, FieldListStatus.Name(), animeSchema.fields[FieldMyListStatus.Name()])
+	mangaListSchema = withField(mangaSchema, FieldListStatus.Name(), mangaSchema.fields[FieldMyListStatus.Name()])
+)
+
+// withField returns copy of schema with one more top-level field.
+func withField(s *fieldSchema, name string, sub *fieldSchema) *fieldSchema {
+	c := &fieldSchema{fields: make(map[string]*fieldSchema, len(s.fields)+1), all: s.all}
+	for k, v := range s.fields {
+		c.fields[k] = v
+	}
+	c.fields[name] = sub
+	return c
+}
+
+func buildSchemas() (anime *fieldSchema, manga *fieldSchema) {
+	anime = &fieldSchema{fields: make(map[string]*fieldSchema)}
+	manga = &fieldSchema{fields: make(map[string]*fieldSchema)}
+
+	picture := leafSchema("medium", "large")
+	shared := map[Field]*fieldSchema{
+		FieldMainPicture:       picture,
+		FieldAlternativeTitles: leafSchema("synonyms", "en", "ja"),
+		FieldGenres:            leafSchema("id", "name"),
+		FieldPictures:          picture,
+		FieldRelatedAnime: {fields: map[string]*fieldSchema{
+			"node": anime, "relation_type": nil, "relation_type_formatted": nil}},
+		FieldRelatedManga: {fields: map[string]*fieldSchema{
+			"node": manga, "relation_type": nil, "relation_type_formatted": nil}},
+	}
+	for _, f := range generalFields {
+		anime.fields[f.Name()] = shared[f]
+		manga.fields[f.Name()] = shared[f]
+	}
+	anime.fields[FieldRecommendations.Name()] = &fieldSchema{fields: map[string]*fieldSchema{
+		"node": anime, "num_recommendations": nil}}
+	manga.fields[FieldRecommendations.Name()] = &fieldSchema{fields: map[string]*fieldSchema{
+		"node": manga, "num_recommendations": nil}}
+
+	anime.fields[FieldMyListStatus.Name()] = leafSchema("status", "score", "num_episodes_watched",
+		"is_rewatching", "start_date", "finish_date", "priority", "num_times_rewatched",
+		"rewatch_value", "tags", "comments", "updated_at")
+	manga.fields[FieldMyListStatus.Name()] = leafSchema("status", "score", "num_volumes_read",
+		"num_chapters_read", "is_rereading", "start_date", "finish_date", "priority",
+		"num_times_reread", "reread_value", "tags", "comments", "updated_at")
+
+	animeOnly := map[Field]*fieldSchema{
+		FieldStartSeason:   leafSchema("year", "season"),
+		FieldBroadcast:     leafSchema("day_of_the_week", "start_time"),
+		FieldStudios:       leafSchema("id", "name"),
+		FieldOpeningThemes: leafSchema("id", "anime_id", "text"),
+		FieldEndingThemes:  leafSchema("id", "anime_id", "text"),
+		FieldVideos:        leafSchema("id", "title", "url", "thumbnail", "created_at", "updated_at"),
+	}
+	for _, f := range animeFields {
+		anime.fields[f.Name()] = animeOnly[f]
+	}
+	anime.fields[FieldStatistics.Name()] = &fieldSchema{fields: map[string]*fieldSchema{
+		"status":         leafSchema("watching", "completed", "on_hold", "dropped", "plan_to_watch"),
+		"num_list_users": nil,
+	}}
+
+	person := leafSchema("id", "first_name", "last_name")
+	magazine := leafSchema("id", "name")
+	mangaOnly := map[string]*fieldSchema{
+		FieldAuthors.Name(): {fields: map[string]*fieldSchema{
+			"node": person, "role": nil, "id": nil, "first_name": nil, "last_name": nil}},
+		FieldSerialization.Name(): {fields: map[string]*fieldSchema{
+			"node": magazine, "role": nil, "id": nil, "name": nil}},
+	}
+	for _, f := range mangaFields {
+		manga.fields[f.Name()] = mangaOnly[f.Name()]
+	}
+
+	anime.all = append(append(Fields{}, generalFields...), animeFields...)
+	manga.all = append(append(Fields{}, generalFields...), mangaFields...)
+	return anime, manga
+}
@@ -0,0 +1,173 @@
+package myanimelist
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestField_With(t *testing.T) {
+	tests := []struct {
+		name  string
+		field Field
+		want  string
+	}{
+		{
+			name:  "Plain sub-fields",
+			field: FieldMyListStatus.With("status", "score"),
+			want:  "my_list_status{status,score}",
+		},
+		{
+			name:  "Nested sub-fields",
+			field: FieldRelatedAnime.With(Field("node").With(FieldTitle, FieldMean), "relation_type"),
+			want:  "related_anime{node{title,mean},relation_type}",
+		},
+		{
+			name:  "Extend predefined nested field",
+			field: FieldAuthors.With("role"),
+			want:  "authors{first_name,last_name,role}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(tt.field); got != tt.want {
+				t.Errorf("With() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFields_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields Fields
+		want   string
+	}{
+		{
+			name:   "Plain fields",
+			fields: Fields{FieldID, FieldTitle, FieldMean},
+			want:   "id,title,mean",
+		},
+		{
+			name:   "Merge duplicates",
+			fields: Fields{FieldTitle, FieldMyListStatus.With("status"), FieldTitle, FieldMyListStatus.With("score")},
+			want:   "title,my_list_status{status,score}",
+		},
+		{
+			name:   "Raw nested string",
+			fields: Fields{"id, related_anime{node{title}, relation_type}"},
+			want:   "id,related_anime{node{title},relation_type}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fields.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFields_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		fields    Fields
+		anime     bool
+		wantErrIn string
+	}{
+		{name: "Known anime fields", fields: Fields{FieldTitle, FieldNumEpisodes, FieldBroadcast}, anime: true},
+		{name: "All anime fields", fields: Fields{FieldAllAvailable}, anime: true},
+		{name: "All manga fields", fields: Fields{FieldAllAvailable}, anime: false},
+		{
+			name:   "Nested anime fields",
+			fields: Fields{FieldRelatedAnime.With(Field("node").With(FieldTitle, FieldNumEpisodes))},
+			anime:  true,
+		},
+		{name: "Unknown field", fields: Fields{"foo"}, anime: true, wantErrIn: `"foo"`},
+		{name: "Manga field at anime", fields: Fields{FieldNumVolumes}, anime: true, wantErrIn: `"num_volumes"`},
+		{
+			name:      "Unknown nested field",
+			fields:    Fields{FieldRelatedManga.With(Field("node").With("num_episodes"))},
+			anime:     false,
+			wantErrIn: `"related_manga.node.num_episodes"`,
+		},
+		{name: "Sub-fields of plain field", fields: Fields{FieldTitle.With("en")}, anime: true, wantErrIn: "no sub-fields"},
+		{name: "Broken syntax", fields: Fields{"authors{first_name"}, anime: false, wantErrIn: "unclosed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.anime {
+				err = tt.fields.ValidateAnime()
+			} else {
+				err = tt.fields.ValidateManga()
+			}
+			if (err != nil) != (tt.wantErrIn != "") {
+				t.Fatalf("Validate() error = %v, want error with %q", err, tt.wantErrIn)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.wantErrIn) {
+				t.Errorf("Validate() error = %v, want error with %q", err, tt.wantErrIn)
+			}
+		})
+	}
+}
+
+func TestAnime_Details_Fields(t *testing.T) {
+	var gotFields string
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFields = r.URL.Query().Get("fields")
+		_, _ = w.Write([]byte(`{"id":1,"title":"Cowboy Bebop"}`))
+	}))
+
+	_, err := mal.Anime.Details(1, FieldTitle, FieldMyListStatus.With("status", "score"))
+	if err != nil {
+		t.Fatalf("Details() error = %v", err)
+	}
+	if want := "title,my_list_status{status,score}"; gotFields != want {
+		t.Errorf("Details() sent fields %q, want %q", gotFields, want)
+	}
+
+	if _, err := mal.Anime.Details(1, "num_chapters"); err == nil {
+		t.Error("Details() accepted manga-only field")
+	}
+	if _, err := mal.Manga.Details(1); err != nil {
+		t.Errorf("Details() without fields error = %v", err)
+	}
+}
+
+func TestListing_Fields(t *testing.T) {
+	var gotFields string
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFields = r.URL.Query().Get("fields")
+		_, _ = w.Write([]byte(`{"data":[{"node":{"id":1,"title":"Berserk","mean":9.47,"num_volumes":42},
+			"list_status":{"status":"reading","score":10}}],"paging":{}}`))
+	}))
+
+	list, err := mal.Manga.List.User("someone", "", "", PagingSettings{},
+		FieldMean, FieldNumVolumes, FieldListStatus.With("status", "score"))
+	if err != nil {
+		t.Fatalf("User() error = %v", err)
+	}
+	if want := "mean,num_volumes,list_status{status,score}"; gotFields != want {
+		t.Errorf("User() sent fields %q, want %q", gotFields, want)
+	}
+	entry := list.Data[0]
+	if entry.Mean != 9.47 || entry.NumVolumes != 42 || entry.ListStatus.Score != 10 {
+		t.Errorf("User() decoded entry %+v", entry)
+	}
+
+	// list_status is added, when caller forgot it
+	if _, err := mal.Anime.List.User("someone", "", "", PagingSettings{}, FieldMean); err != nil {
+		t.Fatalf("User() error = %v", err)
+	}
+	if want := "mean,list_status"; gotFields != want {
+		t.Errorf("User() sent fields %q, want %q", gotFields, want)
+	}
+
+	if _, err := mal.Anime.Seasonal(2020, SeasonSpring, "", PagingSettings{}, FieldNumVolumes); err == nil {
+		t.Error("Seasonal() accepted manga-only field")
+	}
+	if _, err := mal.Anime.Top(RankAll, PagingSettings{}, FieldListStatus); err == nil {
+		t.Error("Top() accepted list_status field")
+	}
+}
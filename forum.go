@@ -1,6 +1,7 @@
 package myanimelist
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -48,7 +49,6 @@ type ForumSubBoard struct {
 
 // ForumTopic retrieves info about topic with provided topicID.
 func (f *Forum) Topic(topicID int, settings PagingSettings) (*ForumTopic, error) {
-	method := http.MethodGet
 	path := fmt.Sprintf("./forum/topic/%d", topicID)
 
 	data := url.Values{}
@@ -57,7 +57,7 @@ func (f *Forum) Topic(topicID int, settings PagingSettings) (*ForumTopic, error)
 
 	topicInfo := &ForumTopic{parent: f}
 
-	if err := f.mal.request(topicInfo, method, path, data); err != nil {
+	if err := f.mal.requestPage(context.Background(), topicInfo, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 	return topicInfo, nil
@@ -66,6 +66,7 @@ func (f *Forum) Topic(topicID int, settings PagingSettings) (*ForumTopic, error)
 // ForumTopic stores topic title, poll, array of posts.
 // Use Prev() and Next() methods to retrieve corresponding result pages.
 type ForumTopic struct {
+	listing
 	parent *Forum
 	Data   struct {
 		Title string      `json:"title"`
@@ -100,19 +101,80 @@ type PollOption struct {
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *ForumTopic) Prev(limit ...int) (result *ForumTopic, err error) {
-	result = &ForumTopic{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *ForumTopic) Prev(limit ...int) (*ForumTopic, error) {
+	return adjacentPage[*ForumTopic, ForumPost](obj, -1, limit)
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *ForumTopic) Next(limit ...int) (result *ForumTopic, err error) {
-	result = &ForumTopic{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *ForumTopic) Next(limit ...int) (*ForumTopic, error) {
+	return adjacentPage[*ForumTopic, ForumPost](obj, 1, limit)
+}
+
+// HasNext reports whether there is next result page.
+func (obj *ForumTopic) HasNext() bool {
+	return obj.Paging.Next != ""
+}
+
+// HasPrev reports whether there is previous result page.
+func (obj *ForumTopic) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
+
+// Items returns entries of current page.
+func (obj *ForumTopic) Items() []ForumPost {
+	return obj.Data.Posts
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *ForumTopic) Iter() *Iterator[ForumPost] {
+	return newIterator[ForumPost](obj)
+}
+
+func (obj *ForumTopic) emptyPage() page[ForumPost] {
+	return &ForumTopic{parent: obj.parent}
+}
+
+func (obj *ForumTopic) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *ForumTopic) client() *MAL {
+	return obj.parent.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *ForumTopic) FetchAll(settings FetchAllSettings) ([]ForumPost, error) {
+	return fetchAll[ForumPost](context.Background(), obj, settings, func(post ForumPost) int { return post.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *ForumTopic) Stream(ctx context.Context) *Stream[ForumPost] {
+	return newStream[ForumPost](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *ForumTopic) Offset(k int) (*ForumTopic, error) {
+	return offsetPage[*ForumTopic, ForumPost](obj, k)
+}
+
+// First returns first result page.
+func (obj *ForumTopic) First() (*ForumTopic, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *ForumTopic) Page(n int) (*ForumTopic, error) {
+	return numberedPage[*ForumTopic, ForumPost](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *ForumTopic) CurrentPage() int {
+	return currentPage[ForumPost](obj)
 }
 
 // ForumSearchSetting represent advanced search on MyAnimeList forum.
@@ -128,7 +190,6 @@ type ForumSearchSettings struct {
 // ForumSearchTopics implements advanced search from website.
 // Use ForumSearchSettings struct to set search options.
 func (f *Forum) Search(searchOpts ForumSearchSettings, settings PagingSettings) (*ForumSearchResult, error) {
-	method := http.MethodGet
 	path := "./forum/topics"
 
 	data := url.Values{}
@@ -157,7 +218,7 @@ func (f *Forum) Search(searchOpts ForumSearchSettings, settings PagingSettings)
 	settings.set(&data)
 
 	result := &ForumSearchResult{parent: f}
-	if err := f.mal.request(result, method, path, data); err != nil {
+	if err := f.mal.requestPage(context.Background(), result, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 
@@ -167,6 +228,7 @@ func (f *Forum) Search(searchOpts ForumSearchSettings, settings PagingSettings)
 // ForumSearchResult stores array with search result entries.
 // Use Prev() and Next() methods to retrieve corresponding result pages.
 type ForumSearchResult struct {
+	listing
 	parent *Forum
 	Data   []ForumSearchEntry `json:"data"`
 	Paging Paging             `json:"paging"`
@@ -187,17 +249,78 @@ type ForumUser struct {
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *ForumSearchResult) Prev(limit ...int) (result *ForumSearchResult, err error) {
-	result = &ForumSearchResult{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *ForumSearchResult) Prev(limit ...int) (*ForumSearchResult, error) {
+	return adjacentPage[*ForumSearchResult, ForumSearchEntry](obj, -1, limit)
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *ForumSearchResult) Next(limit ...int) (result *ForumSearchResult, err error) {
-	result = &ForumSearchResult{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *ForumSearchResult) Next(limit ...int) (*ForumSearchResult, error) {
+	return adjacentPage[*ForumSearchResult, ForumSearchEntry](obj, 1, limit)
+}
+
+// HasNext reports whether there is next result page.
+func (obj *ForumSearchResult) HasNext() bool {
+	return obj.Paging.Next != ""
+}
+
+// HasPrev reports whether there is previous result page.
+func (obj *ForumSearchResult) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
+
+// Items returns entries of current page.
+func (obj *ForumSearchResult) Items() []ForumSearchEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *ForumSearchResult) Iter() *Iterator[ForumSearchEntry] {
+	return newIterator[ForumSearchEntry](obj)
+}
+
+func (obj *ForumSearchResult) emptyPage() page[ForumSearchEntry] {
+	return &ForumSearchResult{parent: obj.parent}
+}
+
+func (obj *ForumSearchResult) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *ForumSearchResult) client() *MAL {
+	return obj.parent.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *ForumSearchResult) FetchAll(settings FetchAllSettings) ([]ForumSearchEntry, error) {
+	return fetchAll[ForumSearchEntry](context.Background(), obj, settings, func(entry ForumSearchEntry) int { return entry.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *ForumSearchResult) Stream(ctx context.Context) *Stream[ForumSearchEntry] {
+	return newStream[ForumSearchEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *ForumSearchResult) Offset(k int) (*ForumSearchResult, error) {
+	return offsetPage[*ForumSearchResult, ForumSearchEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *ForumSearchResult) First() (*ForumSearchResult, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *ForumSearchResult) Page(n int) (*ForumSearchResult, error) {
+	return numberedPage[*ForumSearchResult, ForumSearchEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *ForumSearchResult) CurrentPage() int {
+	return currentPage[ForumSearchEntry](obj)
 }
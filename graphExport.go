@@ -0,0 +1,236 @@
+package myanimelist
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// relationColors are colors of edges in exported graphs.
+var relationColors = map[RelationType]string{
+	RelationSequel:             "#1f77b4",
+	RelationPrequel:            "#aec7e8",
+	RelationAlternativeSetting: "#9467bd",
+	RelationAlternativeVersion: "#c5b0d5",
+	RelationSideStory:          "#2ca02c",
+	RelationParentStory:        "#98df8a",
+	RelationSummary:            "#ff7f0e",
+	RelationFullStory:          "#ffbb78",
+	RelationSpinOff:            "#d62728",
+	RelationAdaptation:         "#8c564b",
+	RelationCharacter:          "#e377c2",
+	RelationOther:              "#7f7f7f",
+}
+
+// Color returns color of relation in exported graphs, in "#rrggbb" form.
+func (r RelationType) Color() string {
+	if c, ok := relationColors[r]; ok {
+		return c
+	}
+	return relationColors[RelationOther]
+}
+
+// String returns key in "kind/id" form, such as "anime/1". It's used as node ID in exported graphs.
+func (k NodeKey) String() string {
+	return k.Kind + "/" + strconv.Itoa(k.ID)
+}
+
+// Year returns year of node's start date, or 0 if it's unknown.
+func (n *GraphNode) Year() int {
+	if d := n.StartDate(); !d.IsZero() {
+		return d.Year
+	}
+	return 0
+}
+
+// Label returns title, media type and year of node, such as "Steins;Gate (TV, 2011)".
+func (n *GraphNode) Label() string {
+	var details []string
+	if t := n.MediaType(); t != "" {
+		details = append(details, t.Label())
+	}
+	if y := n.Year(); y != 0 {
+		details = append(details, strconv.Itoa(y))
+	}
+	if len(details) == 0 {
+		return n.Title()
+	}
+	return fmt.Sprintf("%s (%s)", n.Title(), strings.Join(details, ", "))
+}
+
+// WriteDOT writes graph in Graphviz DOT format. Root node is bold, manga nodes are ellipses,
+// edges are labeled and colored by relation type.
+func (g *RelationGraph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph franchise {")
+	fmt.Fprintln(bw, "\tnode [shape=box];")
+	for _, k := range g.Keys() {
+		n := g.Nodes[k]
+		attrs := []string{"label=" + dotQuote(n.Label())}
+		if k.Kind == KindManga {
+			attrs = append(attrs, "shape=ellipse")
+		}
+		if k == g.Root {
+			attrs = append(attrs, "style=bold")
+		}
+		fmt.Fprintf(bw, "\t%s [%s];\n", dotQuote(k.String()), strings.Join(attrs, ", "))
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(bw, "\t%s -> %s [label=%s, color=%s];\n", dotQuote(e.From.String()), dotQuote(e.To.String()),
+			dotQuote(e.Type.Label()), dotQuote(e.Type.Color()))
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   struct {
+		ID          string        `xml:"id,attr"`
+		EdgeDefault string        `xml:"edgedefault,attr"`
+		Nodes       []graphMLNode `xml:"node"`
+		Edges       []graphMLEdge `xml:"edge"`
+	} `xml:"graph"`
+}
+
+// WriteGraphML writes graph in GraphML format. Nodes have label, kind, title, media_type, year and depth attributes,
+// edges have relation, label and color attributes.
+func (g *RelationGraph) WriteGraphML(w io.Writer) error {
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{"label", "node", "label", "string"},
+			{"kind", "node", "kind", "string"},
+			{"title", "node", "title", "string"},
+			{"media_type", "node", "media_type", "string"},
+			{"year", "node", "year", "int"},
+			{"depth", "node", "depth", "int"},
+			{"relation", "edge", "relation", "string"},
+			{"relation_label", "edge", "label", "string"},
+			{"color", "edge", "color", "string"},
+		},
+	}
+	doc.Graph.ID = g.Root.String()
+	doc.Graph.EdgeDefault = "directed"
+	for _, k := range g.Keys() {
+		n := g.Nodes[k]
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{ID: k.String(), Data: []graphMLData{
+			{"label", n.Label()},
+			{"kind", k.Kind},
+			{"title", n.Title()},
+			{"media_type", string(n.MediaType())},
+			{"year", strconv.Itoa(n.Year())},
+			{"depth", strconv.Itoa(n.Depth)},
+		}})
+	}
+	for _, e := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{Source: e.From.String(), Target: e.To.String(), Data: []graphMLData{
+			{"relation", string(e.Type)},
+			{"relation_label", e.Type.Label()},
+			{"color", e.Type.Color()},
+		}})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type graphJSONNode struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	MalID     int       `json:"mal_id"`
+	Title     string    `json:"title"`
+	MediaType MediaType `json:"media_type"`
+	Year      int       `json:"year,omitempty"`
+	Depth     int       `json:"depth"`
+	Label     string    `json:"label"`
+}
+
+type graphJSONEdge struct {
+	From     string       `json:"from"`
+	To       string       `json:"to"`
+	Relation RelationType `json:"relation"`
+	Label    string       `json:"label"`
+	Color    string       `json:"color"`
+}
+
+// WriteJSON writes graph as JSON object with "root", "nodes" and "edges" keys.
+// Nodes and edges are referenced by NodeKey.String, such as "anime/1".
+func (g *RelationGraph) WriteJSON(w io.Writer) error {
+	doc := struct {
+		Root  string          `json:"root"`
+		Nodes []graphJSONNode `json:"nodes"`
+		Edges []graphJSONEdge `json:"edges"`
+	}{
+		Root:  g.Root.String(),
+		Nodes: make([]graphJSONNode, 0, len(g.Nodes)),
+		Edges: make([]graphJSONEdge, 0, len(g.Edges)),
+	}
+	for _, k := range g.Keys() {
+		n := g.Nodes[k]
+		doc.Nodes = append(doc.Nodes, graphJSONNode{
+			ID:        k.String(),
+			Kind:      k.Kind,
+			MalID:     k.ID,
+			Title:     n.Title(),
+			MediaType: n.MediaType(),
+			Year:      n.Year(),
+			Depth:     n.Depth,
+			Label:     n.Label(),
+		})
+	}
+	for _, e := range g.Edges {
+		doc.Edges = append(doc.Edges, graphJSONEdge{
+			From:     e.From.String(),
+			To:       e.To.String(),
+			Relation: e.Type,
+			Label:    e.Type.Label(),
+			Color:    e.Type.Color(),
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
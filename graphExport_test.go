@@ -0,0 +1,66 @@
+package myanimelist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestRelationGraph_Write(t *testing.T) {
+	relations := map[string][]fakeRelation{
+		"anime/1": {{KindAnime, 2, RelationSequel}, {KindManga, 3, RelationAdaptation}},
+		"anime/2": {{KindAnime, 1, RelationPrequel}},
+		"manga/3": {{KindAnime, 1, RelationAdaptation}},
+	}
+	mal := newFakeMAL(t, fakeFranchise(t, relations, make(map[string]int)))
+	graph, err := mal.Anime.Relations(context.Background(), 1, CrawlSettings{CrossMedia: true})
+	if err != nil {
+		t.Fatalf("Relations() error = %v", err)
+	}
+
+	var dot bytes.Buffer
+	if err := graph.WriteDOT(&dot); err != nil {
+		t.Fatalf("WriteDOT() error = %v", err)
+	}
+	for _, line := range []string{
+		`"anime/1" [label="anime 1 (TV, 2001)", style=bold];`,
+		`"manga/3" [label="manga 3 (TV, 2003)", shape=ellipse];`,
+		`"anime/1" -> "anime/2" [label="Sequel", color="#1f77b4"];`,
+	} {
+		if !strings.Contains(dot.String(), line) {
+			t.Errorf("WriteDOT() has no %s:\n%s", line, dot.String())
+		}
+	}
+
+	var ml bytes.Buffer
+	if err := graph.WriteGraphML(&ml); err != nil {
+		t.Fatalf("WriteGraphML() error = %v", err)
+	}
+	var parsed graphML
+	if err := xml.Unmarshal(ml.Bytes(), &parsed); err != nil {
+		t.Fatalf("WriteGraphML() wrote invalid XML: %v", err)
+	}
+	if len(parsed.Graph.Nodes) != 3 || len(parsed.Graph.Edges) != 4 || parsed.Graph.Edges[0].Data[0].Value != "sequel" {
+		t.Errorf("WriteGraphML() = %s", ml.String())
+	}
+
+	var js bytes.Buffer
+	if err := graph.WriteJSON(&js); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	var decoded struct {
+		Root  string
+		Nodes []graphJSONNode
+		Edges []graphJSONEdge
+	}
+	if err := json.Unmarshal(js.Bytes(), &decoded); err != nil {
+		t.Fatalf("WriteJSON() wrote invalid JSON: %v", err)
+	}
+	if decoded.Root != "anime/1" || len(decoded.Nodes) != 3 || decoded.Nodes[2].Year != 2003 ||
+		len(decoded.Edges) != 4 || decoded.Edges[1].Relation != RelationAdaptation {
+		t.Errorf("WriteJSON() = %s", js.String())
+	}
+}
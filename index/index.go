@@ -0,0 +1,270 @@
+// Package index provides offline search over titles of anime and manga, which were already fetched.
+// Documents are built from AnimeDetails and MangaDetails, including alternative titles and synonyms,
+// and can be searched by prefix, with typos and by title fragments. Index can be saved to disk and loaded back.
+//
+// Index is safe for concurrent use.
+package index
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/camelva/myanimelist-go"
+)
+
+// Document is single searchable anime or manga.
+type Document struct {
+	// Kind is myanimelist.KindAnime or myanimelist.KindManga
+	Kind      string `json:"kind"`
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	MediaType string `json:"media_type,omitempty"`
+	// Titles are alternative titles and synonyms
+	Titles []string `json:"titles,omitempty"`
+	// Popularity is MyAnimeList popularity rank, lower is more popular. Zero means unknown
+	Popularity int `json:"popularity,omitempty"`
+	// Members is amount of users, who have entry in their lists
+	Members int `json:"members,omitempty"`
+}
+
+// Key returns key of document, same as used in myanimelist.RelationGraph.
+func (d *Document) Key() myanimelist.NodeKey {
+	return myanimelist.NodeKey{Kind: d.Kind, ID: d.ID}
+}
+
+// allTitles returns main title and alternative titles.
+func (d *Document) allTitles() []string {
+	return append([]string{d.Title}, d.Titles...)
+}
+
+// AnimeDocument builds document of anime. Request myanimelist.FieldAlternativeTitles, myanimelist.FieldPopularity
+// and myanimelist.FieldNumListUsers to get all searchable titles and popularity.
+func AnimeDocument(d *myanimelist.AnimeDetails) Document {
+	return Document{
+		Kind:       myanimelist.KindAnime,
+		ID:         d.ID,
+		Title:      d.Title,
+		MediaType:  string(d.MediaType),
+		Titles:     alternativeTitles(d.AlternativeTitles),
+		Popularity: d.Popularity,
+		Members:    d.NumListUsers,
+	}
+}
+
+// MangaDocument builds document of manga. See AnimeDocument.
+func MangaDocument(d *myanimelist.MangaDetails) Document {
+	return Document{
+		Kind:       myanimelist.KindManga,
+		ID:         d.ID,
+		Title:      d.Title,
+		MediaType:  string(d.MediaType),
+		Titles:     alternativeTitles(d.AlternativeTitles),
+		Popularity: d.Popularity,
+		Members:    d.NumListUsers,
+	}
+}
+
+func alternativeTitles(t myanimelist.AlternativeTitles) []string {
+	var titles []string
+	for _, s := range append([]string{t.En, t.Ja}, t.Synonyms...) {
+		if s != "" {
+			titles = append(titles, s)
+		}
+	}
+	return titles
+}
+
+// Index is in-memory search index of documents.
+type Index struct {
+	mu   sync.RWMutex
+	docs map[myanimelist.NodeKey]*Document
+	// words maps normalized title words to documents, which contain them
+	words map[string]map[myanimelist.NodeKey]struct{}
+	// grams maps trigrams to words, which contain them, for typo-tolerant lookup
+	grams map[string]map[string]struct{}
+	// vocabulary is sorted list of words for prefix lookup. It's rebuilt by Add and Remove,
+	// so Search never changes index
+	vocabulary []string
+}
+
+// New returns empty index.
+func New() *Index {
+	return &Index{
+		docs:  make(map[myanimelist.NodeKey]*Document),
+		words: make(map[string]map[myanimelist.NodeKey]struct{}),
+		grams: make(map[string]map[string]struct{}),
+	}
+}
+
+// Len returns amount of documents in index.
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return len(ix.docs)
+}
+
+// Get returns document by its kind and ID.
+func (ix *Index) Get(kind string, id int) (Document, bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	d, ok := ix.docs[myanimelist.NodeKey{Kind: kind, ID: id}]
+	if !ok {
+		return Document{}, false
+	}
+	return *d, true
+}
+
+// Documents returns all documents, ordered by kind and ID.
+func (ix *Index) Documents() []Document {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	docs := make([]Document, 0, len(ix.docs))
+	for _, d := range ix.docs {
+		docs = append(docs, *d)
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		if docs[i].Kind != docs[j].Kind {
+			return docs[i].Kind < docs[j].Kind
+		}
+		return docs[i].ID < docs[j].ID
+	})
+	return docs
+}
+
+// AddAnime adds anime to index, replacing previously added versions of them.
+func (ix *Index) AddAnime(anime ...*myanimelist.AnimeDetails) {
+	docs := make([]Document, len(anime))
+	for i, d := range anime {
+		docs[i] = AnimeDocument(d)
+	}
+	ix.Add(docs...)
+}
+
+// AddManga adds manga to index, replacing previously added versions of them.
+func (ix *Index) AddManga(manga ...*myanimelist.MangaDetails) {
+	docs := make([]Document, len(manga))
+	for i, d := range manga {
+		docs[i] = MangaDocument(d)
+	}
+	ix.Add(docs...)
+}
+
+// Add adds documents to index, replacing documents with same kind and ID.
+func (ix *Index) Add(docs ...Document) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	for i := range docs {
+		d := docs[i]
+		d.Titles = append([]string(nil), d.Titles...)
+		ix.remove(d.Key())
+		ix.docs[d.Key()] = &d
+		for w := range documentWords(&d) {
+			ix.addWord(w, d.Key())
+		}
+	}
+	ix.buildVocabulary()
+}
+
+// Remove removes document from index, if it's there.
+func (ix *Index) Remove(kind string, id int) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.remove(myanimelist.NodeKey{Kind: kind, ID: id})
+	ix.buildVocabulary()
+}
+
+func (ix *Index) remove(key myanimelist.NodeKey) {
+	d, ok := ix.docs[key]
+	if !ok {
+		return
+	}
+	delete(ix.docs, key)
+	for w := range documentWords(d) {
+		docs := ix.words[w]
+		delete(docs, key)
+		if len(docs) > 0 {
+			continue
+		}
+		delete(ix.words, w)
+		for _, g := range trigrams(w) {
+			delete(ix.grams[g], w)
+			if len(ix.grams[g]) == 0 {
+				delete(ix.grams, g)
+			}
+		}
+		ix.vocabulary = nil
+	}
+}
+
+// buildVocabulary sorts indexed words for prefix lookup, if they changed. Must be called under write lock.
+func (ix *Index) buildVocabulary() {
+	if ix.vocabulary != nil {
+		return
+	}
+	ix.vocabulary = make([]string, 0, len(ix.words))
+	for w := range ix.words {
+		ix.vocabulary = append(ix.vocabulary, w)
+	}
+	sort.Strings(ix.vocabulary)
+}
+
+func (ix *Index) addWord(w string, key myanimelist.NodeKey) {
+	docs, ok := ix.words[w]
+	if !ok {
+		docs = make(map[myanimelist.NodeKey]struct{})
+		ix.words[w] = docs
+		for _, g := range trigrams(w) {
+			if ix.grams[g] == nil {
+				ix.grams[g] = make(map[string]struct{})
+			}
+			ix.grams[g][w] = struct{}{}
+		}
+		ix.vocabulary = nil
+	}
+	docs[key] = struct{}{}
+}
+
+// documentWords returns unique normalized words of all document's titles.
+func documentWords(d *Document) map[string]struct{} {
+	words := make(map[string]struct{})
+	for _, t := range d.allTitles() {
+		for _, w := range normalize(t) {
+			words[w] = struct{}{}
+		}
+	}
+	return words
+}
+
+// diacritics are folded, so "Kōkaku" is found by "kokaku".
+var diacritics = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a", "ā", "a",
+	"è", "e", "é", "e", "ê", "e", "ë", "e", "ē", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i", "ī", "i",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ø", "o", "ō", "o",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u", "ū", "u",
+	"ñ", "n", "ç", "c",
+)
+
+// normalize splits text into lowercase words without punctuation and diacritics.
+func normalize(s string) []string {
+	return strings.FieldsFunc(diacritics.Replace(strings.ToLower(s)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// trigrams returns unique trigrams of word, padded with spaces, so short words have them too.
+func trigrams(w string) []string {
+	runes := []rune(" " + w + " ")
+	seen := make(map[string]struct{}, len(runes))
+	grams := make([]string, 0, len(runes))
+	for i := 0; i+3 <= len(runes); i++ {
+		g := string(runes[i : i+3])
+		if _, ok := seen[g]; !ok {
+			seen[g] = struct{}{}
+			grams = append(grams, g)
+		}
+	}
+	return grams
+}
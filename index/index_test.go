@@ -0,0 +1,114 @@
+package index
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/camelva/myanimelist-go"
+)
+
+func testIndex() *Index {
+	ix := New()
+	ix.AddAnime(
+		&myanimelist.AnimeDetails{ID: 16498, Title: "Shingeki no Kyojin", NumListUsers: 3800000,
+			AlternativeTitles: myanimelist.AlternativeTitles{En: "Attack on Titan", Ja: "進撃の巨人"}},
+		&myanimelist.AnimeDetails{ID: 35760, Title: "Shingeki no Kyojin Season 3", NumListUsers: 2000000,
+			AlternativeTitles: myanimelist.AlternativeTitles{En: "Attack on Titan Season 3"}},
+		&myanimelist.AnimeDetails{ID: 43, Title: "Koukaku Kidoutai", Popularity: 300,
+			AlternativeTitles: myanimelist.AlternativeTitles{En: "Ghost in the Shell", Synonyms: []string{"Kōkaku Kidōtai"}}},
+	)
+	ix.AddManga(&myanimelist.MangaDetails{ID: 23390, Title: "Shingeki no Kyojin", NumListUsers: 600000})
+	return ix
+}
+
+func TestIndex_Search(t *testing.T) {
+	ix := testIndex()
+	tests := []struct {
+		query string
+		kind  string
+		id    int
+		title string
+	}{
+		{"shingeki", "", 16498, "Shingeki no Kyojin"},
+		{"attack on tit", "", 16498, "Attack on Titan"},
+		{"atack on titan", "", 16498, "Attack on Titan"},
+		{"attack titan season 3", "", 35760, "Attack on Titan Season 3"},
+		{"ghost in the shel", "", 43, "Ghost in the Shell"},
+		{"KOKAKU kidotai", "", 43, "Kōkaku Kidōtai"},
+		{"進撃", "", 16498, "進撃の巨人"},
+		{"kyojin", myanimelist.KindManga, 23390, "Shingeki no Kyojin"},
+		{"jin", myanimelist.KindManga, 23390, "Shingeki no Kyojin"},
+	}
+	for _, tt := range tests {
+		results := ix.Search(tt.query, SearchOptions{Kind: tt.kind})
+		if len(results) == 0 {
+			t.Errorf("Search(%q) found nothing", tt.query)
+			continue
+		}
+		if r := results[0]; r.ID != tt.id || r.Title != tt.title {
+			t.Errorf("Search(%q) = %d %q, want %d %q", tt.query, r.ID, r.Title, tt.id, tt.title)
+		}
+	}
+
+	if results := ix.Search("shingeki", SearchOptions{Limit: 2}); len(results) != 2 || results[1].ID != 35760 {
+		t.Errorf("Search() ordered by popularity = %+v", results)
+	}
+	if results := ix.Search("bleach", SearchOptions{}); len(results) != 0 {
+		t.Errorf("Search(bleach) = %+v", results)
+	}
+
+	ix.Remove(myanimelist.KindAnime, 43)
+	if results := ix.Search("ghost", SearchOptions{}); len(results) != 0 || ix.Len() != 3 {
+		t.Errorf("Search() after Remove() = %+v", results)
+	}
+}
+
+func TestIndex_Concurrent(t *testing.T) {
+	ix := testIndex()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				ix.Add(Document{Kind: myanimelist.KindAnime, ID: 1000 + i*100 + j, Title: fmt.Sprintf("Bleach %d", j)})
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if results := ix.Search("shinge", SearchOptions{}); len(results) == 0 {
+					t.Error("Search() during Add() found nothing")
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if ix.Len() != 204 {
+		t.Errorf("Len() = %d, want 204", ix.Len())
+	}
+}
+
+func TestIndex_SaveFile(t *testing.T) {
+	ix := testIndex()
+	path := filepath.Join(t.TempDir(), "titles.json")
+	if err := ix.SaveFile(path); err != nil {
+		t.Fatalf("SaveFile() error = %v", err)
+	}
+	loaded, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	if loaded.Len() != ix.Len() {
+		t.Errorf("LoadFile() loaded %d documents, want %d", loaded.Len(), ix.Len())
+	}
+	if d, ok := loaded.Get(myanimelist.KindAnime, 43); !ok || d.Popularity != 300 || len(d.Titles) != 2 {
+		t.Errorf("Get() after LoadFile() = %+v", d)
+	}
+	if results := loaded.Search("atack on titan", SearchOptions{}); len(results) == 0 || results[0].ID != 16498 {
+		t.Errorf("Search() after LoadFile() = %+v", results)
+	}
+}
@@ -0,0 +1,64 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// formatVersion is version of saved index. Load rejects other versions.
+const formatVersion = 1
+
+type snapshot struct {
+	Version   int        `json:"version"`
+	Documents []Document `json:"documents"`
+}
+
+// Save writes documents of index as JSON. Search structures aren't saved, Load rebuilds them.
+func (ix *Index) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(snapshot{Version: formatVersion, Documents: ix.Documents()})
+}
+
+// Load reads index, written by Index.Save.
+func Load(r io.Reader) (*Index, error) {
+	var s snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("decoding index: %w", err)
+	}
+	if s.Version != formatVersion {
+		return nil, fmt.Errorf("unsupported index version %d", s.Version)
+	}
+	ix := New()
+	ix.Add(s.Documents...)
+	return ix, nil
+}
+
+// SaveFile saves index to file. File is replaced atomically, so it's never left half-written.
+func (ix *Index) SaveFile(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := ix.Save(tmp); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadFile loads index from file, written by Index.SaveFile.
+func LoadFile(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
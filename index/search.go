@@ -0,0 +1,229 @@
+package index
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/camelva/myanimelist-go"
+	"github.com/camelva/myanimelist-go/internal/fuzzy"
+)
+
+// SearchOptions controls Index.Search.
+type SearchOptions struct {
+	// Kind limits results to myanimelist.KindAnime or myanimelist.KindManga. Empty means both
+	Kind string
+	// Limit is maximal amount of results. Default is 10
+	Limit int
+}
+
+// Result is document, found by Index.Search.
+type Result struct {
+	Document
+	// Title is title of document, which matched query best. It may be alternative title
+	Title string
+	// Score is relevance of document, increased for popular ones.
+	// It's only meaningful in comparison with other results
+	Score float64
+}
+
+// Scores of single query word, matched against title word.
+const (
+	scoreExact  = 1.0
+	scorePrefix = 0.7 // plus up to 0.2 for longer part of title word
+	scoreTypo   = 0.75
+	scoreInfix  = 0.6
+	scoreGram   = 0.5 // multiplied by trigram similarity
+	// bonuses for titles, which are the query or start with it
+	bonusTitle       = 0.2
+	bonusTitlePrefix = 0.1
+	// weight of popularity in final score
+	weightPopularity = 0.1
+)
+
+// Search returns documents with a title, which has matching word for every word of query. Words match,
+// if they're equal, if title word starts with last query word, if they differ by a typo or two,
+// if title word contains query word or if they share enough trigrams.
+// Case, punctuation and common diacritics are ignored. Results are ordered by relevance and popularity.
+func (ix *Index) Search(query string, opts SearchOptions) []Result {
+	tokens := normalize(query)
+	if len(tokens) == 0 {
+		return nil
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 10
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	// matches[i] are scores of title words, similar to i-th query word
+	matches := make([]map[string]float64, len(tokens))
+	var candidates map[myanimelist.NodeKey]struct{}
+	for i, t := range tokens {
+		matches[i] = ix.matchWord(t, i == len(tokens)-1)
+		docs := make(map[myanimelist.NodeKey]struct{})
+		for w := range matches[i] {
+			for k := range ix.words[w] {
+				if _, ok := candidates[k]; ok || candidates == nil {
+					docs[k] = struct{}{}
+				}
+			}
+		}
+		candidates = docs
+	}
+
+	joined := strings.Join(tokens, " ")
+	var results []Result
+	for k := range candidates {
+		d := ix.docs[k]
+		if opts.Kind != "" && d.Kind != opts.Kind {
+			continue
+		}
+		r := Result{Document: *d}
+		for _, title := range d.allTitles() {
+			words := normalize(title)
+			score := titleScore(words, matches)
+			if score == 0 {
+				continue
+			}
+			if t := strings.Join(words, " "); t == joined {
+				score += bonusTitle
+			} else if strings.HasPrefix(t, joined) {
+				score += bonusTitlePrefix
+			}
+			if score > r.Score {
+				r.Score, r.Title = score, title
+			}
+		}
+		if r.Score > 0 {
+			r.Score += weightPopularity * popularity(d)
+			results = append(results, r)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if results[i].Members != results[j].Members {
+			return results[i].Members > results[j].Members
+		}
+		if results[i].Kind != results[j].Kind {
+			return results[i].Kind < results[j].Kind
+		}
+		return results[i].ID < results[j].ID
+	})
+	if len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+	return results
+}
+
+// titleScore returns average score of query words, matched against words of single title,
+// or 0 if some query word doesn't match.
+func titleScore(words []string, matches []map[string]float64) float64 {
+	var total float64
+	for _, m := range matches {
+		var best float64
+		for _, w := range words {
+			if s := m[w]; s > best {
+				best = s
+			}
+		}
+		if best == 0 {
+			return 0
+		}
+		total += best
+	}
+	return total / float64(len(matches))
+}
+
+// popularity returns value from 0 to 1, based on amount of members or popularity rank.
+func popularity(d *Document) float64 {
+	if d.Members > 0 {
+		return math.Min(1, math.Log10(float64(d.Members)+1)/7)
+	}
+	if d.Popularity > 0 {
+		return math.Max(0, 1-math.Log10(float64(d.Popularity))/5)
+	}
+	return 0
+}
+
+// matchWord returns indexed words, similar to query word t, with their scores.
+// Prefixes are matched only for the last query word, which user may be still typing.
+func (ix *Index) matchWord(t string, last bool) map[string]float64 {
+	matches := make(map[string]float64)
+	set := func(w string, score float64) {
+		if score > matches[w] {
+			matches[w] = score
+		}
+	}
+	if _, ok := ix.words[t]; ok {
+		set(t, scoreExact)
+	}
+
+	length := utf8.RuneCountInString(t)
+	if last {
+		from := sort.SearchStrings(ix.vocabulary, t)
+		for _, w := range ix.vocabulary[from:] {
+			if !strings.HasPrefix(w, t) {
+				break
+			}
+			set(w, scorePrefix+0.2*float64(length)/float64(utf8.RuneCountInString(w)))
+		}
+	}
+
+	typos := maxTypos(length)
+	if length < 3 {
+		return matches
+	}
+	grams := trigrams(t)
+	shared := make(map[string]int)
+	for _, g := range grams {
+		for w := range ix.grams[g] {
+			shared[w]++
+		}
+	}
+	for w, count := range shared {
+		wr, tr := []rune(w), []rune(t)
+		distance := typos + 1
+		if abs(len(wr)-len(tr)) <= typos {
+			distance = fuzzy.Levenshtein(tr, wr)
+		}
+		switch {
+		case distance <= typos:
+			set(w, scoreTypo-0.15*float64(distance-1))
+		case last && len(wr) > len(tr) && fuzzy.Levenshtein(tr, wr[:len(tr)]) <= typos:
+			set(w, scoreTypo-0.2)
+		case strings.Contains(w, t):
+			set(w, scoreInfix)
+		default:
+			similarity := 2 * float64(count) / float64(len(grams)+len(trigrams(w)))
+			if similarity >= 0.5 {
+				set(w, scoreGram*similarity)
+			}
+		}
+	}
+	return matches
+}
+
+// maxTypos returns amount of allowed typos in word of provided length.
+func maxTypos(length int) int {
+	switch {
+	case length < 4:
+		return 0
+	case length < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,22 @@
+package fuzzy
+
+import "testing"
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"shingeki", "shingeki", 0},
+		{"kyojin", "kyoujin", 1},
+		{"kōkaku", "kokaku", 1},
+	}
+	for _, tt := range tests {
+		if got := Levenshtein([]rune(tt.a), []rune(tt.b)); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
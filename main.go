@@ -2,6 +2,7 @@
 package myanimelist
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,6 +26,10 @@ type MAL struct {
 
 	logger *log.Logger
 
+	limiter *rateLimiter
+
+	contentPolicy ContentPolicy
+
 	// Auth contain all authorization-related data
 	Auth Auth
 
@@ -37,7 +42,7 @@ type MAL struct {
 // New creates new MyAnimeList client with specified parameters.
 // Every api method require authorization so you need to provide
 // all auth-related data before client's initialisation.
-// If you plan to set user's tokens manually with SetTokenInfo(),
+// If you plan to set user's tokens manually with RestoreTokenInfo(),
 // instead of authorization - you can specify "/" as redirect URL.
 func New(config Config) (*MAL, error) {
 	if config.ClientID == "" {
@@ -51,9 +56,12 @@ func New(config Config) (*MAL, error) {
 	}
 
 	mal := &MAL{
-		host:   apiEndpoint,
-		client: &http.Client{Timeout: 5 * time.Second},
-		logger: log.New(os.Stderr, "[MAL] ", 0),
+		host:    apiEndpoint,
+		client:  &http.Client{Timeout: 5 * time.Second},
+		logger:  log.New(os.Stderr, "[MAL] ", 0),
+		limiter: &rateLimiter{interval: config.RequestInterval},
+
+		contentPolicy: config.ContentPolicy,
 	}
 
 	mal.Auth = Auth{
@@ -79,26 +87,57 @@ func New(config Config) (*MAL, error) {
 }
 
 // Config stores important data to create new MyAnimeList client.
-// HTTPClient and Logger is optional.
+// HTTPClient, Logger and RequestInterval is optional.
 type Config struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
 	HTTPClient   *http.Client
 	Logger       *log.Logger
+	// RequestInterval is minimal interval between two requests to API.
+	// Useful to stay within rate limit with concurrent requests, such as FetchAll().
+	// Zero value disables limitation.
+	RequestInterval time.Duration
+	// ContentPolicy filters entries of searches, rankings, seasonal lists and suggestions.
+	// Zero value disables filtering.
+	ContentPolicy ContentPolicy
 }
 
+var (
+	// ErrNotFound returned when requested object doesn't exist. Check it with errors.Is().
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited returned when API refused request because of too many requests. Check it with errors.Is().
+	ErrRateLimited = errors.New("rate limited")
+)
+
 type errorResponse struct {
-	Err     string `json:"error"`
-	Message string `json:"message, omitempty"`
+	Err        string `json:"error"`
+	Message    string `json:"message,omitempty"`
+	StatusCode int    `json:"-"`
 }
 
 func (e *errorResponse) Error() string {
 	return fmt.Sprintf("myanimelist returned error: %s. With message: %s", e.Err, e.Message)
 }
 
+// Is allows to match error against ErrNotFound and ErrRateLimited.
+func (e *errorResponse) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
 // requestRaw makes actual request and returns everything we got
 func (mal *MAL) requestRaw(method string, path string, data url.Values) (*http.Response, error) {
+	return mal.requestRawContext(context.Background(), method, path, data)
+}
+
+// requestRawContext is requestRaw, which can be cancelled with context
+func (mal *MAL) requestRawContext(ctx context.Context, method string, path string, data url.Values) (*http.Response, error) {
 	var body = new(strings.Reader)
 
 	baseURL, _ := url.Parse(mal.host)
@@ -118,7 +157,7 @@ func (mal *MAL) requestRaw(method string, path string, data url.Values) (*http.R
 		}
 	}
 
-	req, err := http.NewRequest(method, apiURL.String(), body)
+	req, err := http.NewRequestWithContext(ctx, method, apiURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +172,10 @@ func (mal *MAL) requestRaw(method string, path string, data url.Values) (*http.R
 		req.Header.Add("Content-Length", strconv.Itoa(int(body.Size())))
 	}
 
+	if err := mal.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+
 	resp, err := mal.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -142,7 +185,12 @@ func (mal *MAL) requestRaw(method string, path string, data url.Values) (*http.R
 
 // request is small wrapper around requestRaw to avoid multiple ReadBody->Unmarshal->CloseBody chains
 func (mal *MAL) request(destination interface{}, method string, path string, data url.Values) error {
-	resp, err := mal.requestRaw(method, path, data)
+	return mal.requestContext(context.Background(), destination, method, path, data)
+}
+
+// requestContext is request, which can be cancelled with context
+func (mal *MAL) requestContext(ctx context.Context, destination interface{}, method string, path string, data url.Values) error {
+	resp, err := mal.requestRawContext(ctx, method, path, data)
 	if err != nil {
 		return err
 	}
@@ -160,14 +208,18 @@ func (mal *MAL) request(destination interface{}, method string, path string, dat
 		return nil
 	}
 
-	// Try to parse error message
-	errorMsg := new(errorResponse)
-	if err := json.Unmarshal(respBody, errorMsg); err != nil {
-		return err
+	// Try to parse error message. Some errors, such as rate limiting, come without JSON body
+	errorMsg := &errorResponse{StatusCode: resp.StatusCode}
+	if err := json.Unmarshal(respBody, errorMsg); err != nil || errorMsg.Err == "" {
+		errorMsg.Err = http.StatusText(resp.StatusCode)
+		errorMsg.Message = strings.TrimSpace(string(respBody))
 	}
 	return errorMsg
 }
 
+// ErrNoMorePages returned when requested page is out of paged result's bounds.
+var ErrNoMorePages = errors.New("there is no more pages")
+
 type Paging struct {
 	Previous string `json:"previous"`
 	Next     string `json:"next"`
@@ -179,6 +231,9 @@ type Paging struct {
 type PagingSettings struct {
 	Limit  int
 	Offset int
+	// NSFW asks server to include entries, which aren't safe for work.
+	// ContentPolicy of client is still applied to them
+	NSFW bool
 }
 
 func (s *PagingSettings) set(values *url.Values) {
@@ -188,35 +243,29 @@ func (s *PagingSettings) set(values *url.Values) {
 	if s.Offset != 0 {
 		values.Set("offset", strconv.Itoa(s.Offset))
 	}
+	if s.NSFW {
+		values.Set("nsfw", "true")
+	}
 }
 
-func (mal *MAL) getPage(result interface{}, p Paging, direction int8, limit []int) error {
-	var pageURL string
+// requestPage requests result page, described by pageRequest.
+// If result is paged, request is saved into it.
+func (mal *MAL) requestPage(ctx context.Context, result interface{}, req pageRequest) error {
+	// keep endpoints relative to API host, so they can be saved and reused
+	req.endpoint = strings.TrimPrefix(strings.TrimPrefix(req.endpoint, mal.host), "./")
 
-	if direction < 0 {
-		pageURL = p.Previous
-	} else {
-		pageURL = p.Next
+	if err := mal.requestContext(ctx, result, http.MethodGet, req.endpoint, req.values()); err != nil {
+		return err
 	}
 
-	if pageURL == "" {
-		return errors.New("there is no more pages")
+	if f, ok := result.(contentFilter); ok && !mal.contentPolicy.empty() {
+		f.filterContent(&mal.contentPolicy)
 	}
-
-	if len(limit) > 0 {
-		if limit[0] > 0 {
-			pageObj, err := url.Parse(pageURL)
-			if err != nil {
-				return fmt.Errorf("something wrong with url: %s", err)
-			}
-
-			newQuery := pageObj.Query()
-			newQuery.Set("limit", fmt.Sprint(limit[0]))
-			pageObj.RawQuery = newQuery.Encode()
-
-			pageURL = pageObj.String()
-		}
+	if paged, ok := result.(interface{ setRequest(pageRequest) }); ok {
+		paged.setRequest(req)
 	}
-
-	return mal.request(result, http.MethodGet, pageURL, url.Values{})
+	if f, ok := result.(viewFilter); ok {
+		f.filterView()
+	}
+	return nil
 }
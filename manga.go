@@ -1,6 +1,7 @@
 package myanimelist
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -14,16 +15,18 @@ type Manga struct {
 }
 
 // MangaSearch return list of manga, performing search for similar as provided search string.
-func (m *Manga) Search(search string, settings PagingSettings) (*MangaSearchResult, error) {
-	method := http.MethodGet
+func (m *Manga) Search(search string, settings PagingSettings, fields ...Field) (*MangaSearchResult, error) {
 	path := "./manga"
 	data := url.Values{
 		"q": {search},
 	}
+	if err := setFields(data, m.mal.contentPolicy.withFields(fields, "manga"), mangaSchema, "manga"); err != nil {
+		return nil, err
+	}
 	settings.set(&data)
 
 	searchResult := &MangaSearchResult{parent: m}
-	if err := m.mal.request(searchResult, method, path, data); err != nil {
+	if err := m.mal.requestPage(context.Background(), searchResult, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 	return searchResult, nil
@@ -32,58 +35,108 @@ func (m *Manga) Search(search string, settings PagingSettings) (*MangaSearchResu
 // MangaSearchResult stores array with search entries.
 // Use Prev() and Next() methods to retrieve corresponding result pages.
 type MangaSearchResult struct {
+	listing
 	parent *Manga
-	Data   []struct {
-		Node `json:"node"`
-	} `json:"data"`
-	Paging Paging `json:"paging"`
+	Data   []MangaEntry `json:"data"`
+	Paging Paging       `json:"paging"`
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *MangaSearchResult) Prev(limit ...int) (result *MangaSearchResult, err error) {
-	result = &MangaSearchResult{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *MangaSearchResult) Prev(limit ...int) (*MangaSearchResult, error) {
+	return adjacentPage[*MangaSearchResult, MangaEntry](obj, -1, limit)
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *MangaSearchResult) Next(limit ...int) (result *MangaSearchResult, err error) {
-	result = &MangaSearchResult{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *MangaSearchResult) Next(limit ...int) (*MangaSearchResult, error) {
+	return adjacentPage[*MangaSearchResult, MangaEntry](obj, 1, limit)
 }
 
-// MangaDetails returns details about manga with provided ID.
-// You can control which fields to retrieve. For all fields use FieldAllAvailable.
-// With no fields provided api still returns ID, Title and MainPicture fields
-func (m *Manga) Details(mangaID int, fields ...string) (*MangaDetails, error) {
-	method := http.MethodGet
-	path := fmt.Sprintf("./manga/%d", mangaID)
+// HasNext reports whether there is next result page.
+func (obj *MangaSearchResult) HasNext() bool {
+	return obj.Paging.Next != ""
+}
 
-	acceptableArr := append(generalFields, mangaFields...)
-	acceptable := makeList(acceptableArr)
+// HasPrev reports whether there is previous result page.
+func (obj *MangaSearchResult) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
 
-	if fields[0] == FieldAllAvailable {
-		fields = acceptableArr
-	}
+// Items returns entries of current page.
+func (obj *MangaSearchResult) Items() []MangaEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *MangaSearchResult) Iter() *Iterator[MangaEntry] {
+	return newIterator[MangaEntry](obj)
+}
+
+func (obj *MangaSearchResult) emptyPage() page[MangaEntry] {
+	return &MangaSearchResult{parent: obj.parent}
+}
 
+func (obj *MangaSearchResult) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *MangaSearchResult) client() *MAL {
+	return obj.parent.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *MangaSearchResult) FetchAll(settings FetchAllSettings) ([]MangaEntry, error) {
+	return fetchAll[MangaEntry](context.Background(), obj, settings, func(entry MangaEntry) int { return entry.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *MangaSearchResult) Stream(ctx context.Context) *Stream[MangaEntry] {
+	return newStream[MangaEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *MangaSearchResult) Offset(k int) (*MangaSearchResult, error) {
+	return offsetPage[*MangaSearchResult, MangaEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *MangaSearchResult) First() (*MangaSearchResult, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *MangaSearchResult) Page(n int) (*MangaSearchResult, error) {
+	return numberedPage[*MangaSearchResult, MangaEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *MangaSearchResult) CurrentPage() int {
+	return currentPage[MangaEntry](obj)
+}
+
+// MangaDetails returns details about manga with provided ID.
+// You can control which fields to retrieve. For all fields use FieldAllAvailable.
+// Fields can have sub-fields (see Field.With()), unknown fields are reported with error.
+// With no fields provided api still returns ID, Title and MainPicture fields
+func (m *Manga) Details(mangaID int, fields ...Field) (*MangaDetails, error) {
 	data := url.Values{}
-	fieldsString := ""
-	for k, f := range fields {
-		if _, ok := acceptable[f]; !ok {
-			continue
-		}
-		if k != 0 {
-			fieldsString += ", "
-		}
-		fieldsString += f
+	if err := setFields(data, fields, mangaSchema, "manga"); err != nil {
+		return nil, err
 	}
-	data.Set("fields", fieldsString)
+	return m.details(context.Background(), mangaID, data)
+}
 
-	manga := new(MangaDetails)
-	if err := m.mal.request(manga, method, path, data); err != nil {
+// details requests manga with already validated fields.
+func (m *Manga) details(ctx context.Context, mangaID int, data url.Values) (*MangaDetails, error) {
+	method := http.MethodGet
+	path := fmt.Sprintf("./manga/%d", mangaID)
+
+	manga := &MangaDetails{}
+	if err := m.mal.requestContext(ctx, manga, method, path, data); err != nil {
 		return nil, err
 	}
 
@@ -92,68 +145,72 @@ func (m *Manga) Details(mangaID int, fields ...string) (*MangaDetails, error) {
 
 // MangaDetails contain info about certain manga.
 type MangaDetails struct {
-	ID                int     `json:"id"`
-	Title             string  `json:"title"`
-	MainPicture       Picture `json:"main_picture"`
-	AlternativeTitles struct {
-		Synonyms []string `json:"synonyms"`
-		En       string   `json:"en"`
-		Ja       string   `json:"ja"`
-	} `json:"alternative_titles"`
-	StartDate       string    `json:"start_date"`
-	EndDate         string    `json:"end_date"`
-	Synopsis        string    `json:"synopsis"`
-	Mean            float64   `json:"mean"`
-	Rank            int       `json:"rank"`
-	Popularity      int       `json:"popularity"`
-	NumListUsers    int       `json:"num_list_users"`
-	NumScoringUsers int       `json:"num_scoring_users"`
-	Nsfw            string    `json:"nsfw"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
-	MediaType       string    `json:"media_type"`
-	Status          string    `json:"status"`
-	Genres          []Genre   `json:"genres"`
-	MyListStatus    struct {
-		Status          string    `json:"status"`
-		IsRereading     bool      `json:"is_rereading"`
-		NumVolumesRead  int       `json:"num_volumes_read"`
-		NumChaptersRead int       `json:"num_chapters_read"`
-		Score           int       `json:"score"`
-		UpdatedAt       time.Time `json:"updated_at"`
-	} `json:"my_list_status"`
-	NumVolumes  int `json:"num_volumes"`
-	NumChapters int `json:"num_chapters"`
-	Authors     []struct {
-		Node struct {
-			ID        int    `json:"id"`
-			FirstName string `json:"first_name"`
-			LastName  string `json:"last_name"`
-		} `json:"node"`
-		Role string `json:"role"`
-	} `json:"authors"`
-	Pictures     []Picture `json:"pictures"`
-	Background   string    `json:"background"`
-	RelatedAnime []struct {
-		Node                  `json:"node"`
-		RelationType          string `json:"relation_type"`
-		RelationTypeFormatted string `json:"relation_type_formatted"`
-	} `json:"related_anime"`
-	RelatedManga []struct {
-		Node                  `json:"node"`
-		RelationType          string `json:"relation_type"`
-		RelationTypeFormatted string `json:"relation_type_formatted"`
-	} `json:"related_manga"`
-	Recommendations []struct {
-		Node               `json:"node"`
-		NumRecommendations int `json:"num_recommendations"`
-	} `json:"recommendations"`
-	Serialization []struct {
-		Node struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-		} `json:"node"`
-	} `json:"serialization"`
+	ID                int                   `json:"id"`
+	Title             string                `json:"title"`
+	MainPicture       Picture               `json:"main_picture"`
+	AlternativeTitles AlternativeTitles     `json:"alternative_titles"`
+	StartDate         PartialDate           `json:"start_date"`
+	EndDate           PartialDate           `json:"end_date"`
+	Synopsis          string                `json:"synopsis"`
+	Mean              float64               `json:"mean"`
+	Rank              int                   `json:"rank"`
+	Popularity        int                   `json:"popularity"`
+	NumListUsers      int                   `json:"num_list_users"`
+	NumScoringUsers   int                   `json:"num_scoring_users"`
+	Nsfw              NSFW                  `json:"nsfw"`
+	CreatedAt         time.Time             `json:"created_at"`
+	UpdatedAt         time.Time             `json:"updated_at"`
+	MediaType         MediaType             `json:"media_type"`
+	Status            PublishingStatus      `json:"status"`
+	Genres            []Genre               `json:"genres"`
+	MyListStatus      MangaListStatus       `json:"my_list_status"`
+	NumVolumes        int                   `json:"num_volumes"`
+	NumChapters       int                   `json:"num_chapters"`
+	Authors           []Author              `json:"authors"`
+	Pictures          []Picture             `json:"pictures"`
+	Background        string                `json:"background"`
+	RelatedAnime      []RelatedAnime        `json:"related_anime"`
+	RelatedManga      []RelatedManga        `json:"related_manga"`
+	Recommendations   []MangaRecommendation `json:"recommendations"`
+	Serialization     []Serialization       `json:"serialization"`
+}
+
+// Author is person, who worked on manga, with their role.
+type Author struct {
+	Node Person `json:"node"`
+	Role string `json:"role"`
+}
+
+// Person is author of manga.
+type Person struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+// Serialization is magazine, where manga was published.
+type Serialization struct {
+	Node Magazine `json:"node"`
+	Role string   `json:"role"`
+}
+
+// Magazine publishes manga.
+type Magazine struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// MangaEntry is single manga of search result.
+// By default only ID, Title and MainPicture are filled,
+// request additional fields with field selection of corresponding method.
+type MangaEntry struct {
+	MangaDetails `json:"node"`
+}
+
+// MangaRankingEntry is single manga of top list with its rank position.
+type MangaRankingEntry struct {
+	MangaDetails `json:"node"`
+	Ranking      Ranking `json:"ranking"`
 }
 
 // MangaRanking returns list of top manga, for each measurement.
@@ -161,23 +218,25 @@ type MangaDetails struct {
 // Currently available ranks:
 // - RankAll, - RankManga, - RankNovels, - RankOneShots, - RankDoujinshi,
 // - RankManhwa, - RankManhua, - RankByPopularity, - RankFavorite.
-func (m *Manga) Top(rankingType string, settings PagingSettings) (*MangaTop, error) {
+func (m *Manga) Top(rankingType string, settings PagingSettings, fields ...Field) (*MangaTop, error) {
 	// Current working rankings
 	acceptable := makeList(append(generalRankings, mangaRankings...))
 	if _, ok := acceptable[rankingType]; !ok {
 		return nil, errors.New("undefined ranking type")
 	}
 
-	method := http.MethodGet
 	path := "./manga/ranking"
 
 	data := url.Values{
 		"ranking_type": {rankingType},
 	}
+	if err := setFields(data, m.mal.contentPolicy.withFields(fields, "manga"), mangaSchema, "manga"); err != nil {
+		return nil, err
+	}
 	settings.set(&data)
 
 	mangaRank := &MangaTop{parent: m}
-	if err := m.mal.request(mangaRank, method, path, data); err != nil {
+	if err := m.mal.requestPage(context.Background(), mangaRank, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 
@@ -187,28 +246,85 @@ func (m *Manga) Top(rankingType string, settings PagingSettings) (*MangaTop, err
 // MangaRanking contain arrays of Nodes (ID, Title, MainPicture) with their rank position.
 // Use Prev() and Next() methods to retrieve corresponding result pages.
 type MangaTop struct {
+	listing
 	parent *Manga
-	Data   []struct {
-		Node    `json:"node"`
-		Ranking struct {
-			Rank int `json:"rank"`
-		} `json:"ranking"`
-	} `json:"data"`
-	Paging Paging `json:"paging"`
+	Data   []MangaRankingEntry `json:"data"`
+	Paging Paging              `json:"paging"`
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *MangaTop) Prev(limit ...int) (result *MangaTop, err error) {
-	result = &MangaTop{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *MangaTop) Prev(limit ...int) (*MangaTop, error) {
+	return adjacentPage[*MangaTop, MangaRankingEntry](obj, -1, limit)
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *MangaTop) Next(limit ...int) (result *MangaTop, err error) {
-	result = &MangaTop{parent: obj.parent}
-	err = obj.parent.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *MangaTop) Next(limit ...int) (*MangaTop, error) {
+	return adjacentPage[*MangaTop, MangaRankingEntry](obj, 1, limit)
+}
+
+// HasNext reports whether there is next result page.
+func (obj *MangaTop) HasNext() bool {
+	return obj.Paging.Next != ""
+}
+
+// HasPrev reports whether there is previous result page.
+func (obj *MangaTop) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
+
+// Items returns entries of current page.
+func (obj *MangaTop) Items() []MangaRankingEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *MangaTop) Iter() *Iterator[MangaRankingEntry] {
+	return newIterator[MangaRankingEntry](obj)
+}
+
+func (obj *MangaTop) emptyPage() page[MangaRankingEntry] {
+	return &MangaTop{parent: obj.parent}
+}
+
+func (obj *MangaTop) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *MangaTop) client() *MAL {
+	return obj.parent.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *MangaTop) FetchAll(settings FetchAllSettings) ([]MangaRankingEntry, error) {
+	return fetchAll[MangaRankingEntry](context.Background(), obj, settings, func(entry MangaRankingEntry) int { return entry.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *MangaTop) Stream(ctx context.Context) *Stream[MangaRankingEntry] {
+	return newStream[MangaRankingEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *MangaTop) Offset(k int) (*MangaTop, error) {
+	return offsetPage[*MangaTop, MangaRankingEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *MangaTop) First() (*MangaTop, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *MangaTop) Page(n int) (*MangaTop, error) {
+	return numberedPage[*MangaTop, MangaRankingEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *MangaTop) CurrentPage() int {
+	return currentPage[MangaRankingEntry](obj)
 }
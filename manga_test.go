@@ -1,6 +1,7 @@
 package myanimelist
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 )
@@ -122,7 +123,7 @@ func TestMangaSearchResult_Prev(t *testing.T) {
 func TestMAL_Manga_Details(t *testing.T) {
 	type args struct {
 		mangaID int
-		fields  []string
+		fields  []Field
 	}
 	tests := []struct {
 		name    string
@@ -132,7 +133,7 @@ func TestMAL_Manga_Details(t *testing.T) {
 	}{
 		{
 			name: "Berserk",
-			args: args{mangaID: 2, fields: []string{FieldTitle}},
+			args: args{mangaID: 2, fields: []Field{FieldTitle}},
 			want: &MangaDetails{
 				ID:    2,
 				Title: "Berserk",
@@ -270,3 +271,32 @@ func TestMangaTop_Prev(t *testing.T) {
 		})
 	}
 }
+
+func TestMangaDetails_Unmarshal(t *testing.T) {
+	data := []byte(`{
+		"id": 2,
+		"title": "Berserk",
+		"my_list_status": {"status": "reading", "num_chapters_read": 300, "priority": 1,
+			"num_times_reread": 2, "reread_value": 5, "finish_date": "2021-05-06"},
+		"authors": [{"node": {"id": 1868, "first_name": "Kentarou", "last_name": "Miura"}, "role": "Story & Art"}],
+		"serialization": [{"node": {"id": 2, "name": "Young Animal"}, "role": ""}],
+		"related_manga": [{"node": {"id": 3, "title": "Berserk: Prototype"}, "relation_type": "alternative_version"}]
+	}`)
+
+	var details MangaDetails
+	if err := json.Unmarshal(data, &details); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	ls := details.MyListStatus
+	if ls.NumChaptersRead != 300 || ls.Priority != 1 || ls.NumTimesReread != 2 || ls.RereadValue != 5 ||
+		ls.FinishDate.String() != "2021-05-06" {
+		t.Errorf("Unmarshal() list status = %+v", ls)
+	}
+	if details.Authors[0].Node.LastName != "Miura" || details.Serialization[0].Node.Name != "Young Animal" {
+		t.Errorf("Unmarshal() authors/serialization = %+v %+v", details.Authors, details.Serialization)
+	}
+	if details.RelatedManga[0].Title != "Berserk: Prototype" {
+		t.Errorf("Unmarshal() related manga = %+v", details.RelatedManga)
+	}
+}
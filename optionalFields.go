@@ -2,39 +2,39 @@ package myanimelist
 
 // Custom field, applicable to both Anime.Details() and Manga.Details().
 // Transforms into all available fields before sending request.
-const FieldAllAvailable string = "*"
+const FieldAllAvailable Field = "*"
 
 // Shared fields for both Anime.Details() and Manga.Details().
 const (
-	FieldID                string = "id"
-	FieldTitle             string = "title"
-	FieldMainPicture       string = "main_picture"
-	FieldAlternativeTitles string = "alternative_titles"
-	FieldStartDate         string = "start_date"
-	FieldEndDate           string = "end_date"
-	FieldSynopsis          string = "synopsis"
-	FieldMean              string = "mean"
-	FieldRank              string = "rank"
-	FieldPopularity        string = "popularity"
-	FieldNumListUsers      string = "num_list_users"
-	FieldNumScoringUsers   string = "num_scoring_users"
-	FieldNSFW              string = "nsfw"
-	FieldCreatedAt         string = "created_at"
-	FieldUpdatedAt         string = "updated_at"
-	FieldMediaType         string = "media_type"
-	FieldStatus            string = "status"
-	FieldGenres            string = "genres"
-	FieldMyListStatus      string = "my_list_status"
-	FieldPictures          string = "pictures"
-	FieldBackground        string = "background"
-	FieldRelatedAnime      string = "related_anime"
-	FieldRelatedManga      string = "related_manga"
-	FieldRecommendations   string = "recommendations"
-	FieldStudios           string = "studios"
-	FieldStatistics        string = "statistics"
+	FieldID                Field = "id"
+	FieldTitle             Field = "title"
+	FieldMainPicture       Field = "main_picture"
+	FieldAlternativeTitles Field = "alternative_titles"
+	FieldStartDate         Field = "start_date"
+	FieldEndDate           Field = "end_date"
+	FieldSynopsis          Field = "synopsis"
+	FieldMean              Field = "mean"
+	FieldRank              Field = "rank"
+	FieldPopularity        Field = "popularity"
+	FieldNumListUsers      Field = "num_list_users"
+	FieldNumScoringUsers   Field = "num_scoring_users"
+	FieldNSFW              Field = "nsfw"
+	FieldCreatedAt         Field = "created_at"
+	FieldUpdatedAt         Field = "updated_at"
+	FieldMediaType         Field = "media_type"
+	FieldStatus            Field = "status"
+	FieldGenres            Field = "genres"
+	FieldMyListStatus      Field = "my_list_status"
+	FieldPictures          Field = "pictures"
+	FieldBackground        Field = "background"
+	FieldRelatedAnime      Field = "related_anime"
+	FieldRelatedManga      Field = "related_manga"
+	FieldRecommendations   Field = "recommendations"
+	FieldStudios           Field = "studios"
+	FieldStatistics        Field = "statistics"
 )
 
-var generalFields = []string{FieldID, FieldTitle, FieldMainPicture, FieldAlternativeTitles,
+var generalFields = []Field{FieldID, FieldTitle, FieldMainPicture, FieldAlternativeTitles,
 	FieldStartDate, FieldEndDate, FieldSynopsis, FieldMean, FieldRank, FieldPopularity,
 	FieldNumListUsers, FieldNumScoringUsers, FieldNSFW, FieldCreatedAt, FieldUpdatedAt,
 	FieldMediaType, FieldStatus, FieldGenres, FieldMyListStatus, FieldPictures,
@@ -42,26 +42,34 @@ var generalFields = []string{FieldID, FieldTitle, FieldMainPicture, FieldAlterna
 
 // Anime.Details() only fields
 const (
-	FieldNumEpisodes            string = "num_episodes"
-	FieldStartSeason            string = "start_season"
-	FieldBroadcast              string = "broadcast"
-	FieldSource                 string = "source"
-	FieldAverageEpisodeDuration string = "average_episode_duration"
-	FieldRating                 string = "rating"
+	FieldNumEpisodes            Field = "num_episodes"
+	FieldStartSeason            Field = "start_season"
+	FieldBroadcast              Field = "broadcast"
+	FieldSource                 Field = "source"
+	FieldAverageEpisodeDuration Field = "average_episode_duration"
+	FieldRating                 Field = "rating"
+	FieldOpeningThemes          Field = "opening_themes"
+	FieldEndingThemes           Field = "ending_themes"
+	FieldVideos                 Field = "videos"
 )
 
-var animeFields = []string{FieldNumEpisodes, FieldStartSeason, FieldBroadcast, FieldSource,
-	FieldAverageEpisodeDuration, FieldRating, FieldStudios}
+var animeFields = []Field{FieldNumEpisodes, FieldStartSeason, FieldBroadcast, FieldSource,
+	FieldAverageEpisodeDuration, FieldRating, FieldStudios, FieldOpeningThemes, FieldEndingThemes,
+	FieldVideos}
 
 // Manga.Details() only fields.
 const (
-	FieldNumVolumes    string = "num_volumes"
-	FieldNumChapters   string = "num_chapters"
-	FieldAuthors       string = "authors{first_name,last_name}"
-	FieldSerialization string = "serialization{name}"
+	FieldNumVolumes    Field = "num_volumes"
+	FieldNumChapters   Field = "num_chapters"
+	FieldAuthors       Field = "authors{first_name,last_name}"
+	FieldSerialization Field = "serialization{name}"
 )
 
-var mangaFields = []string{FieldNumVolumes, FieldNumChapters, FieldAuthors, FieldSerialization}
+var mangaFields = []Field{FieldNumVolumes, FieldNumChapters, FieldAuthors, FieldSerialization}
+
+// AnimeList.User() and MangaList.User() only field: status of entry in user's list.
+// Server omits it, when other fields are requested, so these methods always add it to fields.
+const FieldListStatus Field = "list_status"
 
 // Shared ranks for both Anime.Top() and Manga.Top().
 const (
@@ -114,42 +122,42 @@ var mangaRankings = []string{RankManga, RankNovels, RankOneShots, RankDoujinshi,
 // Shared statuses, working for Anime.List.User() && Manga.List.User()
 // and for AnimeConfig.SetStatus() && MangaConfig.SetStatus() too.
 const (
-	StatusOnHold    string = "on_hold"
-	StatusDropped   string = "dropped"
-	StatusCompleted string = "completed"
+	StatusOnHold    ListStatus = "on_hold"
+	StatusDropped   ListStatus = "dropped"
+	StatusCompleted ListStatus = "completed"
 )
 
-var generalStatuses = []string{StatusOnHold, StatusDropped, StatusCompleted}
+var generalStatuses = []ListStatus{StatusOnHold, StatusDropped, StatusCompleted}
 
 // Anime-only statuses (Anime.List.User() and AnimeConfig.SetStatus())
 const (
-	StatusWatching    string = "watching"
-	StatusPlanToWatch string = "plan_to_watch"
+	StatusWatching    ListStatus = "watching"
+	StatusPlanToWatch ListStatus = "plan_to_watch"
 )
 
-var animeStatuses = []string{StatusWatching, StatusPlanToWatch}
+var animeStatuses = []ListStatus{StatusWatching, StatusPlanToWatch}
 
 // Manga-only statuses (Manga.List.User() and MangaConfig.SetStatus())
 const (
-	StatusReading    string = "reading"
-	StatusPlanToRead string = "plan_to_read"
+	StatusReading    ListStatus = "reading"
+	StatusPlanToRead ListStatus = "plan_to_read"
 )
 
-var mangaStatuses = []string{StatusReading, StatusPlanToRead}
+var mangaStatuses = []ListStatus{StatusReading, StatusPlanToRead}
 
 // Predefined season values. Used for 'Anime.Seasonal()'
 const (
 	// January, February, March
-	SeasonWinter string = "winter"
+	SeasonWinter SeasonName = "winter"
 	// April, May, June
-	SeasonSpring string = "spring"
+	SeasonSpring SeasonName = "spring"
 	// July, August, September
-	SeasonSummer string = "summer"
+	SeasonSummer SeasonName = "summer"
 	// October, November, December
-	SeasonFall string = "fall"
+	SeasonFall SeasonName = "fall"
 )
 
-var seasons = []string{SeasonWinter, SeasonSpring, SeasonSummer, SeasonFall}
+var seasons = []SeasonName{SeasonWinter, SeasonSpring, SeasonSummer, SeasonFall}
 
 // Used to sort Anime.Seasonal() response
 const (
@@ -0,0 +1,382 @@
+package myanimelist
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// Pager is implemented by every paged result, such as AnimeTop or ForumSearchResult.
+type Pager interface {
+	// HasNext reports whether there is next result page.
+	HasNext() bool
+	// HasPrev reports whether there is previous result page.
+	HasPrev() bool
+}
+
+// page is paged result with items of type T.
+// Methods, shared by all paged results, are implemented by generic helpers below,
+// so result types only wrap them with their own types.
+type page[T any] interface {
+	Pager
+	Items() []T
+	Filtered() int
+	Cursor() Cursor
+	CurrentOffset() int
+	paging() Paging
+	// emptyPage returns new result of same type and parent, to be filled by request
+	emptyPage() page[T]
+	client() *MAL
+	linkRequest(link string) (pageRequest, error)
+	offsetRequest(k int) (pageRequest, error)
+	pageSize(p Paging, count int) int
+}
+
+// pageAt requests page, described by req, into new result of p's type.
+func pageAt[T any](ctx context.Context, p page[T], req pageRequest) (page[T], error) {
+	result := p.emptyPage()
+	if err := p.client().requestPage(ctx, result, req); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// adjacentPage requests next (positive direction) or previous page of obj.
+// Limit overrides page size, if provided.
+func adjacentPage[P page[T], T any](obj P, direction int8, limit []int) (P, error) {
+	link := obj.paging().Next
+	if direction < 0 {
+		link = obj.paging().Previous
+	}
+	result := obj.emptyPage().(P)
+	if link == "" {
+		return result, ErrNoMorePages
+	}
+
+	req, err := obj.linkRequest(link)
+	if err != nil {
+		return result, err
+	}
+	if len(limit) > 0 && limit[0] > 0 {
+		req.limit = limit[0]
+	}
+	return result, obj.client().requestPage(context.Background(), result, req)
+}
+
+// offsetPage requests page of obj's listing, starting at k-th entry.
+func offsetPage[P page[T], T any](obj P, k int) (P, error) {
+	var none P
+	req, err := obj.offsetRequest(k)
+	if err != nil {
+		return none, err
+	}
+	result := obj.emptyPage().(P)
+	if err := obj.client().requestPage(context.Background(), result, req); err != nil {
+		return none, err
+	}
+	if k > 0 && len(result.Items())+result.Filtered() == 0 {
+		return none, ErrNoMorePages
+	}
+	return result, nil
+}
+
+// numberedPage requests n-th page of obj's listing, keeping obj's page size.
+func numberedPage[P page[T], T any](obj P, n int) (P, error) {
+	offset, err := pageOffset(n, obj.pageSize(obj.paging(), len(obj.Items())))
+	if err != nil {
+		var none P
+		return none, err
+	}
+	return offsetPage[P, T](obj, offset)
+}
+
+// currentPage returns number of p (counting from 1).
+func currentPage[T any](p page[T]) int {
+	if size := p.pageSize(p.paging(), len(p.Items())); size > 0 {
+		return p.CurrentOffset()/size + 1
+	}
+	return 1
+}
+
+// Iterator walks over items of paged result, requesting next pages when needed.
+// Usage is similar to bufio.Scanner:
+//
+//	it := result.Iter()
+//	for it.Next() {
+//		item := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iterator[T any] struct {
+	page  page[T]
+	index int
+	item  T
+	err   error
+}
+
+func newIterator[T any](p page[T]) *Iterator[T] {
+	return &Iterator[T]{page: p}
+}
+
+// Next advances iterator to the next item, which will then be available through Item().
+// Returns false when there are no more items or error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil || it.page == nil {
+		return false
+	}
+	for {
+		items := it.page.Items()
+		if it.index < len(items) {
+			it.item = items[it.index]
+			it.index++
+			return true
+		}
+
+		if !it.page.HasNext() {
+			it.page = nil
+			return false
+		}
+		next, err := nextPageContext(context.Background(), it.page)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = next
+		it.index = 0
+	}
+}
+
+// Item returns current item.
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns first error occurred during iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// pageRequest describes request of single result page.
+type pageRequest struct {
+	// endpoint is URL of paged method without query
+	endpoint string
+	// query contains request parameters, except limit and offset
+	query  url.Values
+	limit  int
+	offset int
+}
+
+// newPageRequest creates pageRequest of paged method's first page.
+func newPageRequest(path string, data url.Values) pageRequest {
+	req, _ := parsePageRequest(path, data)
+	return req
+}
+
+// requestFromURL parses page URL, such as Paging.Next, into pageRequest.
+func requestFromURL(pageURL string) (pageRequest, error) {
+	pageObj, err := url.Parse(pageURL)
+	if err != nil {
+		return pageRequest{}, fmt.Errorf("something wrong with url: %s", err)
+	}
+
+	query := pageObj.Query()
+	pageObj.RawQuery = ""
+	return parsePageRequest(pageObj.String(), query)
+}
+
+// parsePageRequest splits limit and offset from rest of the query.
+func parsePageRequest(endpoint string, data url.Values) (pageRequest, error) {
+	var err error
+	query := url.Values{}
+	for k, v := range data {
+		query[k] = append([]string(nil), v...)
+	}
+
+	req := pageRequest{endpoint: endpoint, query: query}
+	if limit := query.Get("limit"); limit != "" {
+		if req.limit, err = strconv.Atoi(limit); err != nil {
+			return pageRequest{}, fmt.Errorf("something wrong with url: %s", err)
+		}
+	}
+	if offset := query.Get("offset"); offset != "" {
+		if req.offset, err = strconv.Atoi(offset); err != nil {
+			return pageRequest{}, fmt.Errorf("something wrong with url: %s", err)
+		}
+	}
+	query.Del("limit")
+	query.Del("offset")
+
+	return req, nil
+}
+
+// listing is embedded into every paged result and remembers request of the page.
+type listing struct {
+	request pageRequest
+	// filtered is amount of entries, removed by ContentPolicy or filtering view
+	filtered int
+}
+
+func (l *listing) setRequest(req pageRequest) {
+	l.request = req
+}
+
+// CurrentOffset returns offset of current page's first entry.
+func (l *listing) CurrentOffset() int {
+	return l.request.offset
+}
+
+// pageSize returns amount of entries per page. If limit wasn't set explicitly,
+// it's taken from paging links or amount of entries on current page.
+func (l *listing) pageSize(p Paging, count int) int {
+	if l.request.limit > 0 {
+		return l.request.limit
+	}
+	for _, link := range []string{p.Next, p.Previous} {
+		if link == "" {
+			continue
+		}
+		if req, err := requestFromURL(link); err == nil && req.limit > 0 {
+			return req.limit
+		}
+	}
+	return count + l.filtered
+}
+
+// linkRequest parses paging link of current page.
+// Parameters of current request, which server didn't include into link, are kept.
+func (l *listing) linkRequest(link string) (pageRequest, error) {
+	req, err := requestFromURL(link)
+	if err != nil {
+		return pageRequest{}, err
+	}
+	for k, v := range l.request.query {
+		if _, ok := req.query[k]; !ok {
+			req.query[k] = append([]string(nil), v...)
+		}
+	}
+	return req, nil
+}
+
+// offsetRequest returns request of page, starting at k-th entry.
+func (l *listing) offsetRequest(k int) (pageRequest, error) {
+	if k < 0 {
+		return pageRequest{}, ErrNoMorePages
+	}
+	req := l.request
+	req.offset = k
+	return req, nil
+}
+
+// pageOffset converts 1-based page number into offset.
+func pageOffset(n int, size int) (int, error) {
+	if n < 1 || size < 1 {
+		return 0, ErrNoMorePages
+	}
+	return (n - 1) * size, nil
+}
+
+// values returns complete query of request.
+func (r pageRequest) values() url.Values {
+	values := url.Values{}
+	for k, v := range r.query {
+		values[k] = append([]string(nil), v...)
+	}
+	settings := PagingSettings{Limit: r.limit, Offset: r.offset}
+	settings.set(&values)
+	return values
+}
+
+// FetchAllSettings controls FetchAll methods of paged results.
+type FetchAllSettings struct {
+	// MaxItems limits amount of returned items. Zero means no limit.
+	MaxItems int
+	// Concurrency is amount of pages requested at once. Default is 4.
+	Concurrency int
+}
+
+// fetchAll collects items of current and all next pages.
+// Page size and offsets are taken from first page's next link,
+// then pages are requested in waves of settings.Concurrency pages,
+// until one of them turns out to be the last one or ctx is done.
+// Items are returned in listing order, without duplicates by key.
+func fetchAll[T any](ctx context.Context, first page[T], settings FetchAllSettings, key func(T) int) ([]T, error) {
+	concurrency := settings.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	seen := make(map[int]struct{})
+	var items []T
+	// add appends new items, returns false when MaxItems reached
+	add := func(pageItems []T) bool {
+		for _, item := range pageItems {
+			if settings.MaxItems > 0 && len(items) >= settings.MaxItems {
+				return false
+			}
+			k := key(item)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			items = append(items, item)
+		}
+		return settings.MaxItems <= 0 || len(items) < settings.MaxItems
+	}
+
+	if !add(first.Items()) || !first.HasNext() {
+		return items, nil
+	}
+
+	next, err := first.linkRequest(first.paging().Next)
+	if err != nil {
+		return nil, err
+	}
+	step := next.limit
+	if step <= 0 {
+		step = len(first.Items()) + first.Filtered()
+	}
+	if step <= 0 {
+		return items, nil
+	}
+
+	type result struct {
+		page page[T]
+		err  error
+	}
+	for {
+		// don't request pages we won't need
+		wave := concurrency
+		if settings.MaxItems > 0 {
+			if needed := (settings.MaxItems - len(items) + step - 1) / step; needed < wave {
+				wave = needed
+			}
+		}
+
+		results := make([]result, wave)
+		var wg sync.WaitGroup
+		for i := 0; i < wave; i++ {
+			req := next
+			req.offset += i * step
+			wg.Add(1)
+			go func(i int, req pageRequest) {
+				defer wg.Done()
+				results[i].page, results[i].err = pageAt(ctx, first, req)
+			}(i, req)
+		}
+		wg.Wait()
+
+		for _, r := range results {
+			if r.err != nil {
+				return nil, r.err
+			}
+			pageItems := r.page.Items()
+			if !add(pageItems) || !r.page.HasNext() || len(pageItems)+r.page.Filtered() < step {
+				return items, nil
+			}
+		}
+		next.offset += wave * step
+	}
+}
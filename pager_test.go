@@ -0,0 +1,323 @@
+package myanimelist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newFakeMAL creates client, which sends every request to provided handler.
+func newFakeMAL(t *testing.T, handler http.Handler) *MAL {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	mal, err := New(Config{ClientID: "mock", ClientSecret: "mock", RedirectURL: "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mal.host = server.URL + "/v2/"
+	return mal
+}
+
+// fakeListing emulates paged endpoint with total entries.
+// Entry at position i has ID i+1.
+func fakeListing(total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit <= 0 {
+			limit = 100
+		}
+
+		type node struct {
+			ID    int    `json:"id"`
+			Title string `json:"title"`
+		}
+		type entry struct {
+			Node node `json:"node"`
+		}
+		resp := struct {
+			Data   []entry `json:"data"`
+			Paging Paging  `json:"paging"`
+		}{Data: []entry{}}
+
+		for i := offset; i < offset+limit && i < total; i++ {
+			resp.Data = append(resp.Data, entry{node{ID: i + 1, Title: fmt.Sprintf("Entry %d", i+1)}})
+		}
+
+		pageURL := func(offset int) string {
+			q := r.URL.Query()
+			q.Set("offset", strconv.Itoa(offset))
+			q.Set("limit", strconv.Itoa(limit))
+			return fmt.Sprintf("http://%s%s?%s", r.Host, r.URL.Path, q.Encode())
+		}
+		if offset+limit < total {
+			resp.Paging.Next = pageURL(offset + limit)
+		}
+		if offset > 0 {
+			prev := offset - limit
+			if prev < 0 {
+				prev = 0
+			}
+			resp.Paging.Previous = pageURL(prev)
+		}
+
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+func TestIterator(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int
+		limit int
+	}{
+		{name: "Several pages", total: 25, limit: 10},
+		{name: "Exactly one page", total: 10, limit: 10},
+		{name: "Empty result", total: 0, limit: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mal := newFakeMAL(t, fakeListing(tt.total))
+
+			result, err := mal.Anime.Search("world", PagingSettings{Limit: tt.limit})
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if result.HasPrev() {
+				t.Error("HasPrev() = true on first page")
+			}
+
+			it := result.Iter()
+			count := 0
+			for it.Next() {
+				count++
+				if it.Item().ID != count {
+					t.Errorf("Item() ID = %d, want %d", it.Item().ID, count)
+				}
+			}
+			if err := it.Err(); err != nil {
+				t.Errorf("Err() = %v", err)
+			}
+			if count != tt.total {
+				t.Errorf("iterated over %d items, want %d", count, tt.total)
+			}
+			if it.Next() {
+				t.Error("Next() = true after end")
+			}
+		})
+	}
+}
+
+func TestIterator_Error(t *testing.T) {
+	calls := 0
+	listing := fakeListing(30)
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"internal","message":"boom"}`))
+			return
+		}
+		listing(w, r)
+	}))
+
+	result, err := mal.Anime.Top(RankAll, PagingSettings{Limit: 10})
+	if err != nil {
+		t.Fatalf("Top() error = %v", err)
+	}
+	it := result.Iter()
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 10 {
+		t.Errorf("iterated over %d items, want 10", count)
+	}
+	if it.Err() == nil {
+		t.Error("Err() = nil, want error from second page")
+	}
+}
+
+func TestFetchAll(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		settings FetchAllSettings
+		want     int
+	}{
+		{name: "Whole list", total: 95, settings: FetchAllSettings{Concurrency: 3}, want: 95},
+		{name: "Single page", total: 7, settings: FetchAllSettings{}, want: 7},
+		{name: "Limited", total: 95, settings: FetchAllSettings{MaxItems: 35, Concurrency: 8}, want: 35},
+		{name: "Exact pages", total: 40, settings: FetchAllSettings{Concurrency: 2}, want: 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := 0
+			listing := fakeListing(tt.total)
+			mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requests++
+				mu.Unlock()
+				listing(w, r)
+			}))
+
+			list, err := mal.Anime.List.User("", "", "", PagingSettings{Limit: 10})
+			if err != nil {
+				t.Fatalf("User() error = %v", err)
+			}
+			got, err := list.FetchAll(tt.settings)
+			if err != nil {
+				t.Fatalf("FetchAll() error = %v", err)
+			}
+			if len(got) != tt.want {
+				t.Fatalf("FetchAll() got %d items, want %d", len(got), tt.want)
+			}
+			for i, entry := range got {
+				if entry.ID != i+1 {
+					t.Fatalf("FetchAll() item %d has ID %d, want %d", i, entry.ID, i+1)
+				}
+			}
+			if tt.settings.MaxItems > 0 {
+				if maxRequests := (tt.settings.MaxItems + 9) / 10; requests > maxRequests {
+					t.Errorf("FetchAll() made %d requests, want at most %d", requests, maxRequests)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchAll_Duplicates(t *testing.T) {
+	listing := fakeListing(30)
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// emulate list, shifted by one entry between requests
+		if r.URL.Query().Get("offset") == "20" {
+			q := r.URL.Query()
+			q.Set("offset", "19")
+			r.URL.RawQuery = q.Encode()
+		}
+		listing(w, r)
+	}))
+
+	result, err := mal.Manga.Search("world", PagingSettings{Limit: 10})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	got, err := result.FetchAll(FetchAllSettings{})
+	if err != nil {
+		t.Fatalf("FetchAll() error = %v", err)
+	}
+	// third page repeats entry 20 and loses entry 30
+	if len(got) != 29 {
+		t.Errorf("FetchAll() got %d items, want 29", len(got))
+	}
+	seen := make(map[int]bool)
+	for _, entry := range got {
+		if seen[entry.ID] {
+			t.Errorf("FetchAll() returned duplicate ID %d", entry.ID)
+		}
+		seen[entry.ID] = true
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := &rateLimiter{interval: 20 * time.Millisecond}
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = limiter.wait(context.Background())
+		}()
+	}
+	wg.Wait()
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("4 requests took %s, want at least 60ms", elapsed)
+	}
+}
+
+func TestPageNavigation(t *testing.T) {
+	mal := newFakeMAL(t, fakeListing(45))
+
+	result, err := mal.Anime.Seasonal(2020, SeasonFall, "", PagingSettings{Limit: 10})
+	if err != nil {
+		t.Fatalf("Seasonal() error = %v", err)
+	}
+	if result.CurrentOffset() != 0 || result.CurrentPage() != 1 || result.HasPrev() || !result.HasNext() {
+		t.Errorf("first page: offset %d, page %d, prev %v, next %v",
+			result.CurrentOffset(), result.CurrentPage(), result.HasPrev(), result.HasNext())
+	}
+
+	third, err := result.Page(3)
+	if err != nil {
+		t.Fatalf("Page(3) error = %v", err)
+	}
+	if third.CurrentOffset() != 20 || third.CurrentPage() != 3 || third.Data[0].ID != 21 {
+		t.Errorf("Page(3): offset %d, page %d, first ID %d", third.CurrentOffset(), third.CurrentPage(), third.Data[0].ID)
+	}
+
+	last, err := third.Offset(40)
+	if err != nil {
+		t.Fatalf("Offset(40) error = %v", err)
+	}
+	if len(last.Data) != 5 || last.HasNext() {
+		t.Errorf("Offset(40) got %d entries, next %v", len(last.Data), last.HasNext())
+	}
+	if _, err := last.Next(); !errors.Is(err, ErrNoMorePages) {
+		t.Errorf("Next() of last page error = %v, want ErrNoMorePages", err)
+	}
+
+	first, err := last.First()
+	if err != nil {
+		t.Fatalf("First() error = %v", err)
+	}
+	if first.Data[0].ID != 1 {
+		t.Errorf("First() starts with ID %d", first.Data[0].ID)
+	}
+	if _, err := first.Prev(); !errors.Is(err, ErrNoMorePages) {
+		t.Errorf("Prev() of first page error = %v, want ErrNoMorePages", err)
+	}
+
+	for _, n := range []int{0, 6} {
+		if _, err := first.Page(n); !errors.Is(err, ErrNoMorePages) {
+			t.Errorf("Page(%d) error = %v, want ErrNoMorePages", n, err)
+		}
+	}
+}
+
+func TestListing_LinkRequest(t *testing.T) {
+	// links of this server drop fields, so client must keep them itself
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fields") != "mean" {
+			t.Errorf("request without fields: %s", r.URL)
+		}
+		if r.URL.Query().Get("offset") == "1" {
+			_, _ = w.Write([]byte(`{"data":[{"node":{"id":2}}],"paging":{}}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[{"node":{"id":1}}],"paging":{"next":"http://%s/v2/anime?q=naruto&limit=1&offset=1"}}`, r.Host)
+	}))
+
+	result, err := mal.Anime.Search("naruto", PagingSettings{Limit: 1}, FieldMean)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	next, err := result.Next()
+	if err != nil || len(next.Data) != 1 || next.Data[0].ID != 2 {
+		t.Fatalf("Next() = %+v, %v", next, err)
+	}
+	all, err := result.FetchAll(FetchAllSettings{})
+	if err != nil || len(all) != 2 {
+		t.Errorf("FetchAll() = %+v, %v", all, err)
+	}
+}
@@ -0,0 +1,103 @@
+package myanimelist
+
+// ContentPolicy filters anime and manga out of searches, rankings, seasonal lists and suggestions,
+// even if server returned them. Zero value allows everything.
+// Number of filtered entries of every page is reported by its Filtered() method.
+type ContentPolicy struct {
+	// BlockedNSFW lists NSFW levels to filter out, such as NSFWBlack
+	BlockedNSFW []NSFW
+	// BlockedRatings lists anime ratings to filter out, such as RatingRx. Manga has no rating
+	BlockedRatings []Rating
+}
+
+// StrictContentPolicy filters out everything, which isn't safe for work.
+var StrictContentPolicy = ContentPolicy{
+	BlockedNSFW:    []NSFW{NSFWGray, NSFWBlack},
+	BlockedRatings: []Rating{RatingRPlus, RatingRx},
+}
+
+func (p *ContentPolicy) empty() bool {
+	return len(p.BlockedNSFW) == 0 && len(p.BlockedRatings) == 0
+}
+
+// AllowsAnime checks whether anime passes policy.
+func (p *ContentPolicy) AllowsAnime(a *AnimeDetails) bool {
+	if _, blocked := makeList(p.BlockedNSFW)[a.Nsfw]; blocked {
+		return false
+	}
+	_, blocked := makeList(p.BlockedRatings)[a.Rating]
+	return !blocked
+}
+
+// AllowsManga checks whether manga passes policy.
+func (p *ContentPolicy) AllowsManga(m *MangaDetails) bool {
+	_, blocked := makeList(p.BlockedNSFW)[m.Nsfw]
+	return !blocked
+}
+
+// withFields adds fields, required to apply policy to listing of kind, to requested ones.
+func (p *ContentPolicy) withFields(requested []Field, kind string) []Field {
+	if p.empty() {
+		return requested
+	}
+	fields := append([]Field(nil), requested...)
+	if len(p.BlockedNSFW) > 0 {
+		fields = append(fields, FieldNSFW)
+	}
+	if kind == "anime" && len(p.BlockedRatings) > 0 {
+		fields = append(fields, FieldRating)
+	}
+	return fields
+}
+
+// contentFilter is implemented by paged results, which are subject to ContentPolicy.
+type contentFilter interface {
+	filterContent(p *ContentPolicy)
+}
+
+// viewFilter is implemented by paged results, which may be filtering views, such as AnimeSeasonal.Only().
+// Pages, requested through view, are filtered too.
+type viewFilter interface {
+	filterView()
+}
+
+// Filtered returns amount of entries of current page, removed by ContentPolicy
+// or by filtering view, such as AnimeSeasonal.Only().
+func (l *listing) Filtered() int {
+	return l.filtered
+}
+
+// filterEntries removes entries, which aren't allowed. Returns amount of removed entries.
+func filterEntries[T any](entries []T, allowed func(*T) bool) ([]T, int) {
+	kept := entries[:0]
+	for i := range entries {
+		if allowed(&entries[i]) {
+			kept = append(kept, entries[i])
+		}
+	}
+	return kept, len(entries) - len(kept)
+}
+
+func (obj *AnimeSearchResult) filterContent(p *ContentPolicy) {
+	obj.Data, obj.filtered = filterEntries(obj.Data, func(e *AnimeEntry) bool { return p.AllowsAnime(&e.AnimeDetails) })
+}
+
+func (obj *AnimeTop) filterContent(p *ContentPolicy) {
+	obj.Data, obj.filtered = filterEntries(obj.Data, func(e *AnimeRankingEntry) bool { return p.AllowsAnime(&e.AnimeDetails) })
+}
+
+func (obj *AnimeSeasonal) filterContent(p *ContentPolicy) {
+	obj.Data, obj.filtered = filterEntries(obj.Data, func(e *AnimeEntry) bool { return p.AllowsAnime(&e.AnimeDetails) })
+}
+
+func (obj *AnimeSuggestions) filterContent(p *ContentPolicy) {
+	obj.Data, obj.filtered = filterEntries(obj.Data, func(e *AnimeEntry) bool { return p.AllowsAnime(&e.AnimeDetails) })
+}
+
+func (obj *MangaSearchResult) filterContent(p *ContentPolicy) {
+	obj.Data, obj.filtered = filterEntries(obj.Data, func(e *MangaEntry) bool { return p.AllowsManga(&e.MangaDetails) })
+}
+
+func (obj *MangaTop) filterContent(p *ContentPolicy) {
+	obj.Data, obj.filtered = filterEntries(obj.Data, func(e *MangaRankingEntry) bool { return p.AllowsManga(&e.MangaDetails) })
+}
@@ -0,0 +1,125 @@
+package myanimelist
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestContentPolicy(t *testing.T) {
+	var gotQuery string
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		// every third entry is adult, every fifth is rx-rated
+		var entries []string
+		for id := offset + 1; id <= offset+10 && id <= 30; id++ {
+			nsfw, rating := NSFWWhite, RatingPG13
+			if id%3 == 0 {
+				nsfw = NSFWBlack
+			}
+			if id%5 == 0 {
+				rating = RatingRx
+			}
+			entries = append(entries, fmt.Sprintf(`{"node":{"id":%d,"nsfw":%q,"rating":%q}}`, id, nsfw, rating))
+		}
+		next := ""
+		if offset+10 < 30 {
+			next = fmt.Sprintf("%s/v2/anime?q=x&limit=10&offset=%d", "http://"+r.Host, offset+10)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"paging":{"next":%q}}`, strings.Join(entries, ","), next)
+	}))
+	mal.contentPolicy = ContentPolicy{BlockedNSFW: []NSFW{NSFWBlack}, BlockedRatings: []Rating{RatingRx}}
+
+	result, err := mal.Anime.Search("x", PagingSettings{Limit: 10, NSFW: true}, FieldMean)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	for _, want := range []string{"nsfw=true", "fields=mean%2Cnsfw%2Crating"} {
+		if !strings.Contains(gotQuery, want) {
+			t.Errorf("Search() query %q doesn't contain %q", gotQuery, want)
+		}
+	}
+	// 3, 5, 6, 9, 10 are blocked
+	if len(result.Data) != 5 || result.Filtered() != 5 {
+		t.Errorf("Search() returned %d entries, filtered %d", len(result.Data), result.Filtered())
+	}
+	for _, e := range result.Data {
+		if e.Nsfw == NSFWBlack || e.Rating == RatingRx {
+			t.Errorf("Search() returned blocked entry %d", e.ID)
+		}
+	}
+	if result.CurrentPage() != 1 {
+		t.Errorf("CurrentPage() = %d, want 1", result.CurrentPage())
+	}
+
+	// filtered pages must not stop fetching early
+	all, err := result.FetchAll(FetchAllSettings{})
+	if err != nil {
+		t.Fatalf("FetchAll() error = %v", err)
+	}
+	if len(all) != 16 {
+		t.Errorf("FetchAll() returned %d entries, want 16", len(all))
+	}
+}
+
+func TestContentPolicy_Manga(t *testing.T) {
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("fields"); got != "nsfw" {
+			t.Errorf("Top() requested fields %q, want nsfw", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"node":{"id":1,"nsfw":"white"},"ranking":{"rank":1}},
+			{"node":{"id":2,"nsfw":"gray"},"ranking":{"rank":2}}],"paging":{}}`))
+	}))
+	mal.contentPolicy = StrictContentPolicy
+
+	result, err := mal.Manga.Top(RankAll, PagingSettings{})
+	if err != nil {
+		t.Fatalf("Top() error = %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].ID != 1 || result.Filtered() != 1 {
+		t.Errorf("Top() = %+v, filtered %d", result.Data, result.Filtered())
+	}
+}
+
+func TestContentPolicy_Offset(t *testing.T) {
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		// entries 3 and 4 are adult, so page at offset 2 is filtered completely
+		var entries []string
+		for id := offset + 1; id <= offset+2 && id <= 6; id++ {
+			nsfw := NSFWWhite
+			if id == 3 || id == 4 {
+				nsfw = NSFWBlack
+			}
+			entries = append(entries, fmt.Sprintf(`{"node":{"id":%d,"nsfw":%q}}`, id, nsfw))
+		}
+		next := ""
+		if offset+2 < 6 {
+			next = fmt.Sprintf("%s/v2/anime?q=x&limit=2&offset=%d", "http://"+r.Host, offset+2)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"paging":{"next":%q}}`, strings.Join(entries, ","), next)
+	}))
+	mal.contentPolicy = StrictContentPolicy
+
+	result, err := mal.Anime.Search("x", PagingSettings{Limit: 2})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	filtered, err := result.Offset(2)
+	if err != nil {
+		t.Fatalf("Offset(2) error = %v", err)
+	}
+	if len(filtered.Data) != 0 || filtered.Filtered() != 2 || !filtered.HasNext() {
+		t.Errorf("Offset(2) returned %d entries, filtered %d, next %v",
+			len(filtered.Data), filtered.Filtered(), filtered.HasNext())
+	}
+
+	if _, err := result.Offset(6); err != ErrNoMorePages {
+		t.Errorf("Offset(6) error = %v, want ErrNoMorePages", err)
+	}
+}
@@ -0,0 +1,295 @@
+package myanimelist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNoSnapshot is returned by SnapshotStore, when requested snapshot doesn't exist.
+var ErrNoSnapshot = errors.New("no ranking snapshot")
+
+// RankingSnapshot is ranking listing at certain moment.
+type RankingSnapshot struct {
+	// Kind is KindAnime or KindManga
+	Kind        string        `json:"kind"`
+	RankingType string        `json:"ranking_type"`
+	TakenAt     time.Time     `json:"taken_at"`
+	Entries     []RankedEntry `json:"entries"`
+}
+
+// RankedEntry is anime or manga of RankingSnapshot.
+type RankedEntry struct {
+	ID    int     `json:"id"`
+	Title string  `json:"title"`
+	Rank  int     `json:"rank"`
+	Mean  float64 `json:"mean,omitempty"`
+	// Members is amount of users, who have entry in their lists
+	Members int `json:"members,omitempty"`
+}
+
+// SnapshotSettings controls taking of ranking snapshots.
+type SnapshotSettings struct {
+	// MaxItems limits snapshot to top entries. Zero means whole ranking,
+	// which is thousands of entries for RankAll
+	MaxItems int
+	// NSFW includes NSFW entries, see PagingSettings
+	NSFW bool
+	// Concurrency is amount of pages requested at once, see FetchAllSettings
+	Concurrency int
+}
+
+// snapshotFields are fields, recorded in snapshots in addition to rank.
+var snapshotFields = []Field{FieldMean, FieldNumListUsers}
+
+// Snapshot records anime ranking of provided type, such as RankAll or RankAiring.
+// Take snapshots regularly and compare them with DiffRankings to track movement.
+func (a *Anime) Snapshot(rankingType string, settings SnapshotSettings) (*RankingSnapshot, error) {
+	takenAt := time.Now().UTC().Truncate(time.Second)
+	top, err := a.Top(rankingType, PagingSettings{Limit: 500, NSFW: settings.NSFW}, snapshotFields...)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := top.FetchAll(FetchAllSettings{MaxItems: settings.MaxItems, Concurrency: settings.Concurrency})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &RankingSnapshot{Kind: KindAnime, RankingType: rankingType, TakenAt: takenAt,
+		Entries: make([]RankedEntry, len(entries))}
+	for i, e := range entries {
+		snapshot.Entries[i] = RankedEntry{ID: e.ID, Title: e.Title, Rank: e.Ranking.Rank, Mean: e.Mean, Members: e.NumListUsers}
+	}
+	return snapshot, nil
+}
+
+// Snapshot records manga ranking of provided type. See Anime.Snapshot.
+func (m *Manga) Snapshot(rankingType string, settings SnapshotSettings) (*RankingSnapshot, error) {
+	takenAt := time.Now().UTC().Truncate(time.Second)
+	top, err := m.Top(rankingType, PagingSettings{Limit: 500, NSFW: settings.NSFW}, snapshotFields...)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := top.FetchAll(FetchAllSettings{MaxItems: settings.MaxItems, Concurrency: settings.Concurrency})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &RankingSnapshot{Kind: KindManga, RankingType: rankingType, TakenAt: takenAt,
+		Entries: make([]RankedEntry, len(entries))}
+	for i, e := range entries {
+		snapshot.Entries[i] = RankedEntry{ID: e.ID, Title: e.Title, Rank: e.Ranking.Rank, Mean: e.Mean, Members: e.NumListUsers}
+	}
+	return snapshot, nil
+}
+
+// SnapshotStore keeps ranking snapshots in directory, one JSON file per snapshot.
+type SnapshotStore struct {
+	Dir string
+}
+
+// snapshotTime is format of time in snapshot file names.
+const snapshotTime = "20060102T150405Z"
+
+func (s SnapshotStore) prefix(kind, rankingType string) string {
+	return kind + "-" + rankingType + "-"
+}
+
+func (s SnapshotStore) path(kind, rankingType string, takenAt time.Time) string {
+	return filepath.Join(s.Dir, s.prefix(kind, rankingType)+takenAt.UTC().Format(snapshotTime)+".json")
+}
+
+// Save writes snapshot into store, creating directory if needed.
+// File is replaced atomically, so it's never left half-written.
+func (s SnapshotStore) Save(snapshot *RankingSnapshot) error {
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.Dir, "snapshot-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := json.NewEncoder(tmp).Encode(snapshot); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(snapshot.Kind, snapshot.RankingType, snapshot.TakenAt))
+}
+
+// List returns times of stored snapshots of ranking, the oldest first.
+func (s SnapshotStore) List(kind, rankingType string) ([]time.Time, error) {
+	files, err := os.ReadDir(s.Dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	prefix := s.prefix(kind, rankingType)
+	var times []time.Time
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		t, err := time.Parse(snapshotTime, strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".json"))
+		if err != nil {
+			// not a snapshot
+			continue
+		}
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times, nil
+}
+
+// Load reads snapshot, taken at provided time. Returns ErrNoSnapshot if it's not in store.
+func (s SnapshotStore) Load(kind, rankingType string, takenAt time.Time) (*RankingSnapshot, error) {
+	data, err := os.ReadFile(s.path(kind, rankingType, takenAt))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNoSnapshot
+		}
+		return nil, err
+	}
+	var snapshot RankingSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("decoding snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// Latest returns the newest snapshot of ranking. Returns ErrNoSnapshot if there are none.
+func (s SnapshotStore) Latest(kind, rankingType string) (*RankingSnapshot, error) {
+	return s.Before(kind, rankingType, maxTime)
+}
+
+// Before returns the newest snapshot of ranking, taken not later than t.
+// For weekly reports use Before(kind, rankingType, time.Now().AddDate(0, 0, -7)).
+// Returns ErrNoSnapshot if there are none.
+func (s SnapshotStore) Before(kind, rankingType string, t time.Time) (*RankingSnapshot, error) {
+	times, err := s.List(kind, rankingType)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(times) - 1; i >= 0; i-- {
+		if !times[i].After(t) {
+			return s.Load(kind, rankingType, times[i])
+		}
+	}
+	return nil, ErrNoSnapshot
+}
+
+// RankingMove is change of single entry between two snapshots.
+type RankingMove struct {
+	ID    int
+	Title string
+	// OldRank and NewRank are zero, if entry is absent in older or newer snapshot
+	OldRank int
+	NewRank int
+	OldMean float64
+	NewMean float64
+}
+
+// RankChange returns amount of positions entry climbed. Fallers have negative change.
+func (m RankingMove) RankChange() int {
+	if m.OldRank == 0 || m.NewRank == 0 {
+		return 0
+	}
+	return m.OldRank - m.NewRank
+}
+
+// ScoreChange returns change of mean score.
+func (m RankingMove) ScoreChange() float64 {
+	if m.OldRank == 0 || m.NewRank == 0 {
+		return 0
+	}
+	return m.NewMean - m.OldMean
+}
+
+// RankingDiff is difference between two snapshots of same ranking.
+type RankingDiff struct {
+	From time.Time
+	To   time.Time
+	// New are entries, which appeared in ranking, ordered by new rank
+	New []RankingMove
+	// Dropped are entries, which left ranking, ordered by old rank
+	Dropped []RankingMove
+	// Moved are entries, present in both snapshots, which rank or score changed, ordered by new rank
+	Moved []RankingMove
+}
+
+// DiffRankings compares older and newer snapshots of same ranking.
+// Snapshots, limited by SnapshotSettings.MaxItems, report entries, which fell below limit, as dropped.
+func DiffRankings(older, newer *RankingSnapshot) (*RankingDiff, error) {
+	if older.Kind != newer.Kind || older.RankingType != newer.RankingType {
+		return nil, fmt.Errorf("can't compare %s %s ranking with %s %s ranking",
+			older.Kind, older.RankingType, newer.Kind, newer.RankingType)
+	}
+
+	diff := &RankingDiff{From: older.TakenAt, To: newer.TakenAt}
+	old := make(map[int]RankedEntry, len(older.Entries))
+	for _, e := range older.Entries {
+		old[e.ID] = e
+	}
+	for _, e := range newer.Entries {
+		move := RankingMove{ID: e.ID, Title: e.Title, NewRank: e.Rank, NewMean: e.Mean}
+		prev, ok := old[e.ID]
+		if !ok {
+			diff.New = append(diff.New, move)
+			continue
+		}
+		delete(old, e.ID)
+		move.OldRank, move.OldMean = prev.Rank, prev.Mean
+		if move.OldRank != move.NewRank || move.OldMean != move.NewMean {
+			diff.Moved = append(diff.Moved, move)
+		}
+	}
+	for _, e := range old {
+		diff.Dropped = append(diff.Dropped, RankingMove{ID: e.ID, Title: e.Title, OldRank: e.Rank, OldMean: e.Mean})
+	}
+
+	byNewRank := func(moves []RankingMove) {
+		sort.Slice(moves, func(i, j int) bool { return moves[i].NewRank < moves[j].NewRank })
+	}
+	byNewRank(diff.New)
+	byNewRank(diff.Moved)
+	sort.Slice(diff.Dropped, func(i, j int) bool { return diff.Dropped[i].OldRank < diff.Dropped[j].OldRank })
+	return diff, nil
+}
+
+// Climbers returns up to n entries, which climbed the most positions. Zero n means all of them.
+func (d *RankingDiff) Climbers(n int) []RankingMove {
+	return d.movers(n, func(m RankingMove) int { return m.RankChange() })
+}
+
+// Fallers returns up to n entries, which fell the most positions. Zero n means all of them.
+func (d *RankingDiff) Fallers(n int) []RankingMove {
+	return d.movers(n, func(m RankingMove) int { return -m.RankChange() })
+}
+
+// movers returns entries with positive change, the biggest first; ties keep rank order.
+func (d *RankingDiff) movers(n int, change func(RankingMove) int) []RankingMove {
+	var moves []RankingMove
+	for _, m := range d.Moved {
+		if change(m) > 0 {
+			moves = append(moves, m)
+		}
+	}
+	sort.SliceStable(moves, func(i, j int) bool { return change(moves[i]) > change(moves[j]) })
+	if n > 0 && len(moves) > n {
+		moves = moves[:n]
+	}
+	return moves
+}
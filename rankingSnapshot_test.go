@@ -0,0 +1,106 @@
+package myanimelist
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAnime_Snapshot(t *testing.T) {
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ranking_type") != RankAiring || r.URL.Query().Get("limit") != "500" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"data":[
+			{"node":{"id":10,"title":"First","mean":9.1,"num_list_users":1000},"ranking":{"rank":1}},
+			{"node":{"id":20,"title":"Second","mean":8.9},"ranking":{"rank":2}}],"paging":{}}`))
+	}))
+
+	snapshot, err := mal.Anime.Snapshot(RankAiring, SnapshotSettings{})
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	want := RankedEntry{ID: 10, Title: "First", Rank: 1, Mean: 9.1, Members: 1000}
+	if snapshot.Kind != KindAnime || len(snapshot.Entries) != 2 || snapshot.Entries[0] != want || snapshot.TakenAt.IsZero() {
+		t.Errorf("Snapshot() = %+v", snapshot)
+	}
+}
+
+func TestDiffRankings(t *testing.T) {
+	week := time.Date(2021, 5, 3, 12, 0, 0, 0, time.UTC)
+	snapshot := func(takenAt time.Time, entries ...RankedEntry) *RankingSnapshot {
+		return &RankingSnapshot{Kind: KindAnime, RankingType: RankAll, TakenAt: takenAt, Entries: entries}
+	}
+	older := snapshot(week,
+		RankedEntry{ID: 1, Rank: 1, Mean: 9.1},
+		RankedEntry{ID: 2, Rank: 2, Mean: 9.0},
+		RankedEntry{ID: 3, Rank: 3, Mean: 8.9},
+		RankedEntry{ID: 4, Rank: 4, Mean: 8.8},
+		RankedEntry{ID: 5, Rank: 5, Mean: 8.7})
+	newer := snapshot(week.AddDate(0, 0, 7),
+		RankedEntry{ID: 4, Rank: 1, Mean: 9.2},
+		RankedEntry{ID: 1, Rank: 2, Mean: 9.1},
+		RankedEntry{ID: 6, Rank: 3, Mean: 9.0},
+		RankedEntry{ID: 2, Rank: 4, Mean: 8.8},
+		RankedEntry{ID: 5, Rank: 5, Mean: 8.75})
+
+	store := SnapshotStore{Dir: t.TempDir() + "/rankings"}
+	if _, err := store.Latest(KindAnime, RankAll); !errors.Is(err, ErrNoSnapshot) {
+		t.Errorf("Latest() of empty store error = %v", err)
+	}
+	for _, s := range []*RankingSnapshot{newer, older} {
+		if err := store.Save(s); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	if times, err := store.List(KindAnime, RankAll); err != nil || len(times) != 2 || !times[0].Equal(week) {
+		t.Errorf("List() = %v, %v", times, err)
+	}
+	latest, err := store.Latest(KindAnime, RankAll)
+	if err != nil || !latest.TakenAt.Equal(newer.TakenAt) {
+		t.Fatalf("Latest() = %+v, %v", latest, err)
+	}
+	previous, err := store.Before(KindAnime, RankAll, latest.TakenAt.AddDate(0, 0, -7))
+	if err != nil || !previous.TakenAt.Equal(week) || len(previous.Entries) != 5 {
+		t.Fatalf("Before() = %+v, %v", previous, err)
+	}
+
+	diff, err := DiffRankings(previous, latest)
+	if err != nil {
+		t.Fatalf("DiffRankings() error = %v", err)
+	}
+	ids := func(moves []RankingMove) string {
+		result := make([]int, len(moves))
+		for i, m := range moves {
+			result[i] = m.ID
+		}
+		return fmt.Sprint(result)
+	}
+	if got := ids(diff.New); got != "[6]" {
+		t.Errorf("New = %s", got)
+	}
+	if got := ids(diff.Dropped); got != "[3]" {
+		t.Errorf("Dropped = %s", got)
+	}
+	// 5 kept rank, but its score changed
+	if got := ids(diff.Moved); got != "[4 1 2 5]" {
+		t.Errorf("Moved = %s", got)
+	}
+	if got := ids(diff.Climbers(0)); got != "[4]" || diff.Climbers(0)[0].RankChange() != 3 {
+		t.Errorf("Climbers() = %s", got)
+	}
+	if got := ids(diff.Fallers(1)); got != "[2]" {
+		t.Errorf("Fallers(1) = %s", got)
+	}
+	if change := diff.Moved[3].ScoreChange(); change < 0.049 || change > 0.051 {
+		t.Errorf("ScoreChange() = %v", change)
+	}
+
+	airing := snapshot(week)
+	airing.RankingType = RankAiring
+	if _, err := DiffRankings(older, airing); err == nil {
+		t.Error("DiffRankings() of different rankings succeeded")
+	}
+}
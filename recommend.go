@@ -0,0 +1,330 @@
+package myanimelist
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// RecommendSettings controls AnimeList.Recommend.
+type RecommendSettings struct {
+	// Seeds is amount of the best rated list entries, which recommendations are requested. Default is 30
+	Seeds int
+	// Limit is maximal amount of returned recommendations. Default is 20
+	Limit int
+	// Concurrency is amount of details requested at once, see BatchSettings
+	Concurrency int
+}
+
+// Recommendation is anime, recommended by AnimeList.Recommend.
+type Recommendation struct {
+	// Anime contains genres, studios, media type and mean score,
+	// unless its details failed to load
+	Anime *AnimeDetails
+	// Score is rank of recommendation, it's only meaningful in comparison with other recommendations
+	Score float64
+	// Because lists entries of user's list, which recommendations lead to this anime, the most important first.
+	// It's empty for popular anime, recommended to users without watched entries
+	Because []RecommendationSource
+	// GenreAffinity and StudioAffinity are from -1 (user dislikes anime of same genres or studios) to 1
+	GenreAffinity  float64
+	StudioAffinity float64
+}
+
+// RecommendationSource is entry of user's list, which contributed to Recommendation.
+type RecommendationSource struct {
+	Anime     *AnimeDetails
+	UserScore int
+	// NumRecommendations is amount of MyAnimeList users, who recommended anime for fans of this entry
+	NumRecommendations int
+	// Contribution is part of Recommendation.Score, which came from this entry
+	Contribution float64
+}
+
+// recommendListFields are fields of user's list, required to build taste profile.
+var recommendListFields = []Field{FieldGenres, FieldStudios}
+
+// recommendFields are fields of recommended anime, required to rank them.
+var recommendFields = []Field{FieldTitle, FieldMediaType, FieldMean, FieldGenres, FieldStudios}
+
+// Recommend suggests anime, which aren't in user's list yet (for current user use empty username).
+// Unlike Anime.Suggestions, it's computed locally from MyAnimeList recommendations of the best rated list entries,
+// weighted by user's scores and number of recommendations,
+// and adjusted by user's affinity to genres and studios. Every recommendation explains,
+// which list entries contributed to it.
+// If list has no liked entries, the most popular anime are recommended instead,
+// ranked by user's interest in their genres, which is taken from planned entries too.
+// Details, which failed to load, are skipped. Error is returned, if list can't be fetched or ctx is done.
+func (al *AnimeList) Recommend(ctx context.Context, username string, settings RecommendSettings) ([]Recommendation, error) {
+	if settings.Seeds <= 0 {
+		settings.Seeds = 30
+	}
+	if settings.Limit <= 0 {
+		settings.Limit = 20
+	}
+	batch := BatchSettings{Concurrency: settings.Concurrency}
+
+	list, err := al.user(ctx, username, "", "", PagingSettings{Limit: 1000}, recommendListFields...)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := fetchAll[UserAnimeListEntry](ctx, list, FetchAllSettings{},
+		func(entry UserAnimeListEntry) int { return entry.ID })
+	if err != nil {
+		return nil, err
+	}
+	profile := newTasteProfile(entries)
+	seedIDs := profile.seeds(settings.Seeds)
+	if len(seedIDs) == 0 {
+		return al.recommendPopular(ctx, profile, settings.Limit)
+	}
+
+	seeds, err := al.anime.DetailsMany(ctx, seedIDs, batch, FieldRecommendations)
+	if err != nil {
+		return nil, err
+	}
+	candidates := profile.candidates(seeds.Results)
+
+	// only the best candidates are worth requesting their details;
+	// affinity adjusts score by at most half, so twice the limit is enough
+	if len(candidates) > 2*settings.Limit {
+		candidates = candidates[:2*settings.Limit]
+	}
+	ids := make([]int, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.Anime.ID
+	}
+	details, err := al.anime.DetailsMany(ctx, ids, batch, recommendFields...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range candidates {
+		if d, ok := details.Results[candidates[i].Anime.ID]; ok {
+			candidates[i].Anime = d
+		}
+		profile.adjust(&candidates[i])
+	}
+
+	sortRecommendations(candidates)
+	if len(candidates) > settings.Limit {
+		candidates = candidates[:settings.Limit]
+	}
+	return candidates, nil
+}
+
+// recommendPopular recommends popular anime, when user's list has nothing to base recommendations on.
+func (al *AnimeList) recommendPopular(ctx context.Context, profile *tasteProfile, limit int) ([]Recommendation, error) {
+	// entries of user's list are skipped, so request more, than needed
+	n := 4*limit + len(profile.entries)
+	if n > 500 {
+		n = 500
+	}
+	top, err := al.anime.top(ctx, RankByPopularity, PagingSettings{Limit: n}, recommendFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := profile.popular(top.Data)
+	for i := range candidates {
+		profile.adjust(&candidates[i])
+	}
+	sortRecommendations(candidates)
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+// tasteProfile describes user's preferences, based on their list.
+type tasteProfile struct {
+	entries map[int]*UserAnimeListEntry
+	// weights of list entries, from -1 (disliked) to 1 (loved)
+	weights map[int]float64
+	// genres and studios are average weights of entries with them
+	genres  map[int]float64
+	studios map[int]float64
+	// interests are shares of planned entries with genre, halved. They are used for genres,
+	// which have no weighted entries
+	interests map[int]float64
+}
+
+func newTasteProfile(entries []UserAnimeListEntry) *tasteProfile {
+	p := &tasteProfile{
+		entries: make(map[int]*UserAnimeListEntry, len(entries)),
+		weights: make(map[int]float64, len(entries)),
+	}
+	genres := make(map[int][]float64)
+	studios := make(map[int][]float64)
+	planned := make(map[int]int)
+	var numPlanned int
+	for i := range entries {
+		e := &entries[i]
+		p.entries[e.ID] = e
+		w, ok := entryWeight(e.ListStatus)
+		if !ok {
+			if e.ListStatus.Status == StatusPlanToWatch {
+				numPlanned++
+				for _, g := range e.Genres {
+					planned[g.ID]++
+				}
+			}
+			continue
+		}
+		p.weights[e.ID] = w
+		for _, g := range e.Genres {
+			genres[g.ID] = append(genres[g.ID], w)
+		}
+		for _, s := range e.Studios {
+			studios[s.ID] = append(studios[s.ID], w)
+		}
+	}
+	p.genres = averages(genres)
+	p.studios = averages(studios)
+	p.interests = make(map[int]float64, len(planned))
+	for g, n := range planned {
+		p.interests[g] = 0.5 * float64(n) / float64(numPlanned)
+	}
+	return p
+}
+
+// entryWeight returns how much user liked entry. Scored entries are weighted by score,
+// unscored - by status. Planned entries say nothing about taste.
+func entryWeight(status AnimeListStatus) (float64, bool) {
+	if status.Score > 0 {
+		return float64(status.Score-5) / 5, true
+	}
+	switch status.Status {
+	case StatusCompleted:
+		return 0.4, true
+	case StatusWatching:
+		return 0.3, true
+	case StatusOnHold:
+		return 0.1, true
+	case StatusDropped:
+		return -0.5, true
+	}
+	return 0, false
+}
+
+func averages(values map[int][]float64) map[int]float64 {
+	result := make(map[int]float64, len(values))
+	for k, vs := range values {
+		result[k] = mean(vs)
+	}
+	return result
+}
+
+// seeds returns IDs of up to n liked entries, the most liked first.
+func (p *tasteProfile) seeds(n int) []int {
+	var ids []int
+	for id, w := range p.weights {
+		if w > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if p.weights[ids[i]] != p.weights[ids[j]] {
+			return p.weights[ids[i]] > p.weights[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > n {
+		ids = ids[:n]
+	}
+	return ids
+}
+
+// candidates collects recommendations of seeds, which aren't in user's list, ordered by score.
+func (p *tasteProfile) candidates(seeds map[int]*AnimeDetails) []Recommendation {
+	found := make(map[int]*Recommendation)
+	for id, seed := range seeds {
+		entry := p.entries[id]
+		for i := range seed.Recommendations {
+			rec := &seed.Recommendations[i]
+			if _, ok := p.entries[rec.ID]; ok || rec.NumRecommendations <= 0 {
+				continue
+			}
+			c, ok := found[rec.ID]
+			if !ok {
+				anime := rec.AnimeDetails
+				c = &Recommendation{Anime: &anime}
+				found[rec.ID] = c
+			}
+			contribution := p.weights[id] * math.Log1p(float64(rec.NumRecommendations))
+			c.Score += contribution
+			c.Because = append(c.Because, RecommendationSource{
+				Anime:              &entry.AnimeDetails,
+				UserScore:          entry.ListStatus.Score,
+				NumRecommendations: rec.NumRecommendations,
+				Contribution:       contribution,
+			})
+		}
+	}
+
+	result := make([]Recommendation, 0, len(found))
+	for _, c := range found {
+		sort.Slice(c.Because, func(i, j int) bool {
+			if c.Because[i].Contribution != c.Because[j].Contribution {
+				return c.Because[i].Contribution > c.Because[j].Contribution
+			}
+			return c.Because[i].Anime.ID < c.Because[j].Anime.ID
+		})
+		result = append(result, *c)
+	}
+	sortRecommendations(result)
+	return result
+}
+
+// popular turns popular anime, which aren't in user's list, into recommendations,
+// scored by their position in ranking.
+func (p *tasteProfile) popular(top []AnimeRankingEntry) []Recommendation {
+	result := make([]Recommendation, 0, len(top))
+	for i := range top {
+		if _, ok := p.entries[top[i].ID]; ok {
+			continue
+		}
+		anime := top[i].AnimeDetails
+		result = append(result, Recommendation{Anime: &anime, Score: 1 / math.Log2(float64(i)+2)})
+	}
+	return result
+}
+
+// adjust sets affinities of recommendation and scales its score by them.
+func (p *tasteProfile) adjust(r *Recommendation) {
+	var genres, studios []float64
+	for _, g := range r.Anime.Genres {
+		if w, ok := p.genres[g.ID]; ok {
+			genres = append(genres, w)
+		} else if w, ok := p.interests[g.ID]; ok {
+			genres = append(genres, w)
+		}
+	}
+	for _, s := range r.Anime.Studios {
+		if w, ok := p.studios[s.ID]; ok {
+			studios = append(studios, w)
+		}
+	}
+	r.GenreAffinity = mean(genres)
+	r.StudioAffinity = mean(studios)
+	r.Score *= 1 + 0.3*r.GenreAffinity + 0.2*r.StudioAffinity
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func sortRecommendations(recs []Recommendation) {
+	sort.Slice(recs, func(i, j int) bool {
+		if recs[i].Score != recs[j].Score {
+			return recs[i].Score > recs[j].Score
+		}
+		return recs[i].Anime.ID < recs[j].Anime.ID
+	})
+}
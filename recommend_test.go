@@ -0,0 +1,128 @@
+package myanimelist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestAnimeList_Recommend(t *testing.T) {
+	// 1 and 2 are liked, 3 is dropped, 4 is only planned
+	list := `{"data":[
+		{"node":{"id":1,"genres":[{"id":1}],"studios":[{"id":10}]},"list_status":{"status":"completed","score":10}},
+		{"node":{"id":2,"genres":[{"id":2}]},"list_status":{"status":"completed","score":9}},
+		{"node":{"id":3,"genres":[{"id":3}]},"list_status":{"status":"dropped","score":2}},
+		{"node":{"id":4},"list_status":{"status":"plan_to_watch"}}],"paging":{}}`
+	details := map[int]string{
+		1:   `"recommendations":[{"node":{"id":100},"num_recommendations":20},{"node":{"id":4},"num_recommendations":50},{"node":{"id":101},"num_recommendations":5}]`,
+		2:   `"recommendations":[{"node":{"id":100},"num_recommendations":3},{"node":{"id":102},"num_recommendations":10}]`,
+		100: `"genres":[{"id":1}],"studios":[{"id":10}]`,
+		101: `"genres":[{"id":3}]`,
+		102: `"genres":[{"id":2}]`,
+	}
+	var mu sync.Mutex
+	requested := make(map[int]bool)
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/animelist") {
+			if !strings.Contains(r.URL.Query().Get("fields"), "list_status") {
+				t.Errorf("list requested without list_status: %s", r.URL)
+			}
+			_, _ = w.Write([]byte(list))
+			return
+		}
+		id, _ := strconv.Atoi(path.Base(r.URL.Path))
+		mu.Lock()
+		requested[id] = true
+		mu.Unlock()
+		_, _ = fmt.Fprintf(w, `{"id":%d,"title":"Anime %d",%s}`, id, id, details[id])
+	}))
+
+	recs, err := mal.Anime.List.Recommend(context.Background(), "", RecommendSettings{})
+	if err != nil {
+		t.Fatalf("Recommend() error = %v", err)
+	}
+	ids := make([]int, len(recs))
+	for i, r := range recs {
+		ids[i] = r.Anime.ID
+	}
+	if got := fmt.Sprint(ids); got != "[100 102 101]" {
+		t.Errorf("Recommend() = %s, want [100 102 101]", got)
+	}
+	if requested[3] || requested[4] {
+		t.Error("Recommend() requested recommendations of disliked or planned entry")
+	}
+
+	best := recs[0]
+	if len(best.Because) != 2 || best.Because[0].Anime.ID != 1 || best.Because[0].UserScore != 10 ||
+		best.Because[1].NumRecommendations != 3 {
+		t.Errorf("Recommend() explanation = %+v", best.Because)
+	}
+	if best.GenreAffinity != 1 || best.StudioAffinity != 1 || best.Anime.Title != "Anime 100" {
+		t.Errorf("Recommend() best = %+v", best)
+	}
+	if recs[2].GenreAffinity >= 0 {
+		t.Errorf("Recommend() affinity to genre of dropped anime = %v", recs[2].GenreAffinity)
+	}
+}
+
+func TestAnimeList_Recommend_ColdStart(t *testing.T) {
+	// nothing is liked: 1 is planned, 2 is dropped
+	list := `{"data":[
+		{"node":{"id":1,"genres":[{"id":5}]},"list_status":{"status":"plan_to_watch"}},
+		{"node":{"id":2,"genres":[{"id":6}]},"list_status":{"status":"dropped"}}],"paging":{}}`
+	top := `{"data":[
+		{"node":{"id":1,"genres":[{"id":5}]},"ranking":{"rank":1}},
+		{"node":{"id":10,"genres":[{"id":6}]},"ranking":{"rank":2}},
+		{"node":{"id":11,"genres":[{"id":5}]},"ranking":{"rank":3}},
+		{"node":{"id":12},"ranking":{"rank":4}}],"paging":{}}`
+	listRequests := 0
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/animelist"):
+			listRequests++
+			_, _ = w.Write([]byte(list))
+		case strings.HasSuffix(r.URL.Path, "/anime/ranking"):
+			if r.URL.Query().Get("ranking_type") != RankByPopularity ||
+				!strings.Contains(r.URL.Query().Get("fields"), "genres") {
+				t.Errorf("unexpected ranking request %s", r.URL)
+			}
+			_, _ = w.Write([]byte(top))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+
+	recs, err := mal.Anime.List.Recommend(context.Background(), "", RecommendSettings{})
+	if err != nil {
+		t.Fatalf("Recommend() error = %v", err)
+	}
+	ids := make([]int, len(recs))
+	for i, r := range recs {
+		ids[i] = r.Anime.ID
+		if len(r.Because) != 0 {
+			t.Errorf("Recommend() explanation of popular anime = %+v", r.Because)
+		}
+	}
+	// planned genre lifts 11 above 10, which has genre of dropped anime
+	if got := fmt.Sprint(ids); got != "[11 10 12]" {
+		t.Errorf("Recommend() = %s, want [11 10 12]", got)
+	}
+	if recs[0].GenreAffinity != 0.5 {
+		t.Errorf("Recommend() affinity to planned genre = %v, want 0.5", recs[0].GenreAffinity)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := mal.Anime.List.Recommend(ctx, "", RecommendSettings{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Recommend() with canceled context error = %v", err)
+	}
+	if listRequests != 1 {
+		t.Errorf("list requested %d times, want 1", listRequests)
+	}
+}
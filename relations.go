@@ -0,0 +1,306 @@
+package myanimelist
+
+import (
+	"context"
+	"sort"
+)
+
+// RelationType is kind of relation between two anime or manga.
+type RelationType string
+
+const (
+	RelationSequel             RelationType = "sequel"
+	RelationPrequel            RelationType = "prequel"
+	RelationAlternativeSetting RelationType = "alternative_setting"
+	RelationAlternativeVersion RelationType = "alternative_version"
+	RelationSideStory          RelationType = "side_story"
+	RelationParentStory        RelationType = "parent_story"
+	RelationSummary            RelationType = "summary"
+	RelationFullStory          RelationType = "full_story"
+	RelationSpinOff            RelationType = "spin_off"
+	RelationAdaptation         RelationType = "adaptation"
+	RelationCharacter          RelationType = "character"
+	RelationOther              RelationType = "other"
+)
+
+var relationTypeLabels = map[RelationType]string{
+	RelationSequel:             "Sequel",
+	RelationPrequel:            "Prequel",
+	RelationAlternativeSetting: "Alternative setting",
+	RelationAlternativeVersion: "Alternative version",
+	RelationSideStory:          "Side story",
+	RelationParentStory:        "Parent story",
+	RelationSummary:            "Summary",
+	RelationFullStory:          "Full story",
+	RelationSpinOff:            "Spin-off",
+	RelationAdaptation:         "Adaptation",
+	RelationCharacter:          "Character",
+	RelationOther:              "Other",
+}
+
+func (r RelationType) String() string { return string(r) }
+
+// Label returns human-readable relation, such as "Side story".
+func (r RelationType) Label() string { return label(relationTypeLabels, r) }
+
+// Kinds of graph nodes.
+const (
+	KindAnime = "anime"
+	KindManga = "manga"
+)
+
+// NodeKey identifies anime or manga in RelationGraph.
+type NodeKey struct {
+	// Kind is KindAnime or KindManga
+	Kind string
+	ID   int
+}
+
+// GraphNode is anime or manga of RelationGraph. Exactly one of Anime and Manga is set.
+type GraphNode struct {
+	Key NodeKey
+	// Depth is distance from graph's root
+	Depth int
+	Anime *AnimeDetails
+	Manga *MangaDetails
+}
+
+// Title returns title of node's anime or manga.
+func (n *GraphNode) Title() string {
+	if n.Anime != nil {
+		return n.Anime.Title
+	}
+	return n.Manga.Title
+}
+
+// MediaType returns media type of node's anime or manga.
+func (n *GraphNode) MediaType() MediaType {
+	if n.Anime != nil {
+		return n.Anime.MediaType
+	}
+	return n.Manga.MediaType
+}
+
+// StartDate returns start date of node's anime or manga.
+func (n *GraphNode) StartDate() PartialDate {
+	if n.Anime != nil {
+		return n.Anime.StartDate
+	}
+	return n.Manga.StartDate
+}
+
+// relations returns outgoing relations of node's anime or manga.
+func (n *GraphNode) relations() []GraphEdge {
+	var related []RelatedAnime
+	var relatedManga []RelatedManga
+	if n.Anime != nil {
+		related, relatedManga = n.Anime.RelatedAnime, n.Anime.RelatedManga
+	} else {
+		related, relatedManga = n.Manga.RelatedAnime, n.Manga.RelatedManga
+	}
+
+	edges := make([]GraphEdge, 0, len(related)+len(relatedManga))
+	for _, r := range related {
+		edges = append(edges, GraphEdge{From: n.Key, To: NodeKey{KindAnime, r.ID}, Type: r.RelationType})
+	}
+	for _, r := range relatedManga {
+		edges = append(edges, GraphEdge{From: n.Key, To: NodeKey{KindManga, r.ID}, Type: r.RelationType})
+	}
+	return edges
+}
+
+// GraphEdge is directed relation: To is Type of From. For example, To is sequel of From.
+type GraphEdge struct {
+	From NodeKey
+	To   NodeKey
+	Type RelationType
+}
+
+// RelationGraph is anime and manga, connected by their relations.
+type RelationGraph struct {
+	Root  NodeKey
+	Nodes map[NodeKey]*GraphNode
+	Edges []GraphEdge
+	// Errors contains nodes, which failed to load. Their relations weren't followed
+	Errors map[NodeKey]error
+}
+
+// Node returns node by its key, or nil if it's not in graph.
+func (g *RelationGraph) Node(key NodeKey) *GraphNode {
+	return g.Nodes[key]
+}
+
+// Out returns relations of node.
+func (g *RelationGraph) Out(key NodeKey) []GraphEdge {
+	var edges []GraphEdge
+	for _, e := range g.Edges {
+		if e.From == key {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// Keys returns keys of all nodes, ordered by depth, kind and ID.
+func (g *RelationGraph) Keys() []NodeKey {
+	keys := make([]NodeKey, 0, len(g.Nodes))
+	for k := range g.Nodes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := g.Nodes[keys[i]], g.Nodes[keys[j]]
+		if a.Depth != b.Depth {
+			return a.Depth < b.Depth
+		}
+		if a.Key.Kind != b.Key.Kind {
+			return a.Key.Kind < b.Key.Kind
+		}
+		return a.Key.ID < b.Key.ID
+	})
+	return keys
+}
+
+// CrawlSettings controls building of RelationGraph.
+type CrawlSettings struct {
+	// MaxDepth limits distance from root. Zero means no limit,
+	// which may result in huge graph, if "character" and "other" relations are followed
+	MaxDepth int
+	// Relations lists relation types to follow. Empty list means all of them
+	Relations []RelationType
+	// CrossMedia allows to follow relations to other kind, such as manga of anime root
+	CrossMedia bool
+	// Concurrency is amount of details requested at once, see BatchSettings
+	Concurrency int
+	// Fields are requested in addition to fields, required to build graph,
+	// for example FieldMyListStatus. Anime-only fields, such as FieldNumEpisodes,
+	// aren't requested for manga and vice versa
+	Fields []Field
+}
+
+// relationFields are fields, required to build RelationGraph.
+var relationFields = []Field{FieldTitle, FieldMediaType, FieldStartDate, FieldStatus,
+	FieldRelatedAnime, FieldRelatedManga}
+
+// kindFields splits fields into ones, valid for anime and for manga.
+// Fields, which are valid for neither of them, are rejected.
+func kindFields(fields []Field) (anime []Field, manga []Field, err error) {
+	for _, f := range fields {
+		_, animeErr := Fields{f}.build(animeSchema, "anime")
+		_, mangaErr := Fields{f}.build(mangaSchema, "manga")
+		if animeErr != nil && mangaErr != nil {
+			return nil, nil, animeErr
+		}
+		if animeErr == nil {
+			anime = append(anime, f)
+		}
+		if mangaErr == nil {
+			manga = append(manga, f)
+		}
+	}
+	return anime, manga, nil
+}
+
+// Relations builds graph of anime's franchise, following relations recursively.
+// Details of every level are requested in batches and obey Config.RequestInterval.
+// Anime, which failed to load, are listed in RelationGraph.Errors.
+// Error is returned for invalid fields or when ctx is done.
+func (a *Anime) Relations(ctx context.Context, animeID int, settings CrawlSettings) (*RelationGraph, error) {
+	return crawlRelations(ctx, a.mal, NodeKey{KindAnime, animeID}, settings)
+}
+
+// Relations builds graph of manga's franchise, following relations recursively. See Anime.Relations.
+func (m *Manga) Relations(ctx context.Context, mangaID int, settings CrawlSettings) (*RelationGraph, error) {
+	return crawlRelations(ctx, m.mal, NodeKey{KindManga, mangaID}, settings)
+}
+
+func crawlRelations(ctx context.Context, mal *MAL, root NodeKey, settings CrawlSettings) (*RelationGraph, error) {
+	graph := &RelationGraph{
+		Root:   root,
+		Nodes:  make(map[NodeKey]*GraphNode),
+		Errors: make(map[NodeKey]error),
+	}
+	allowed := makeList(settings.Relations)
+	follows := func(e GraphEdge) bool {
+		if !settings.CrossMedia && e.To.Kind != root.Kind {
+			return false
+		}
+		_, ok := allowed[e.Type]
+		return len(allowed) == 0 || ok
+	}
+	animeFields, mangaFields, err := kindFields(append(append([]Field(nil), relationFields...), settings.Fields...))
+	if err != nil {
+		return nil, err
+	}
+	batch := BatchSettings{Concurrency: settings.Concurrency}
+
+	// visited contains queued, loaded and failed nodes, so cycles are never requested twice
+	visited := map[NodeKey]bool{root: true}
+	level := []NodeKey{root}
+	for depth := 0; len(level) > 0; depth++ {
+		var animeIDs, mangaIDs []int
+		for _, k := range level {
+			if k.Kind == KindAnime {
+				animeIDs = append(animeIDs, k.ID)
+			} else {
+				mangaIDs = append(mangaIDs, k.ID)
+			}
+		}
+
+		var loaded []*GraphNode
+		if len(animeIDs) > 0 {
+			result, err := mal.Anime.DetailsMany(ctx, animeIDs, batch, animeFields...)
+			if err != nil {
+				return nil, err
+			}
+			for id, d := range result.Results {
+				loaded = append(loaded, &GraphNode{Key: NodeKey{KindAnime, id}, Depth: depth, Anime: d})
+			}
+			for id, err := range result.Errors {
+				graph.Errors[NodeKey{KindAnime, id}] = err
+			}
+		}
+		if len(mangaIDs) > 0 {
+			result, err := mal.Manga.DetailsMany(ctx, mangaIDs, batch, mangaFields...)
+			if err != nil {
+				return nil, err
+			}
+			for id, d := range result.Results {
+				loaded = append(loaded, &GraphNode{Key: NodeKey{KindManga, id}, Depth: depth, Manga: d})
+			}
+			for id, err := range result.Errors {
+				graph.Errors[NodeKey{KindManga, id}] = err
+			}
+		}
+
+		level = nil
+		for _, n := range loaded {
+			graph.Nodes[n.Key] = n
+			if settings.MaxDepth > 0 && depth >= settings.MaxDepth {
+				continue
+			}
+			for _, e := range n.relations() {
+				if follows(e) && !visited[e.To] {
+					visited[e.To] = true
+					level = append(level, e.To)
+				}
+			}
+		}
+		// keep requests order stable
+		sort.Slice(level, func(i, j int) bool {
+			if level[i].Kind != level[j].Kind {
+				return level[i].Kind < level[j].Kind
+			}
+			return level[i].ID < level[j].ID
+		})
+	}
+
+	// edges between loaded nodes, including ones from the deepest level back to known nodes
+	for _, k := range graph.Keys() {
+		for _, e := range graph.Nodes[k].relations() {
+			if _, ok := graph.Nodes[e.To]; ok && follows(e) {
+				graph.Edges = append(graph.Edges, e)
+			}
+		}
+	}
+	return graph, nil
+}
@@ -0,0 +1,145 @@
+package myanimelist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type fakeRelation struct {
+	kind     string
+	id       int
+	relation RelationType
+}
+
+// fakeFranchise serves details of anime and manga with provided relations.
+// Nodes without relations entry respond with 404. Requests are counted by "kind/id".
+func fakeFranchise(t *testing.T, relations map[string][]fakeRelation, requests map[string]int) http.HandlerFunc {
+	var mu sync.Mutex
+	return func(w http.ResponseWriter, r *http.Request) {
+		kind := path.Base(path.Dir(r.URL.Path))
+		id, _ := strconv.Atoi(path.Base(r.URL.Path))
+		key := fmt.Sprintf("%s/%d", kind, id)
+		mu.Lock()
+		requests[key]++
+		mu.Unlock()
+
+		rels, ok := relations[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not_found"}`))
+			return
+		}
+		var anime, manga []string
+		for _, rel := range rels {
+			node := fmt.Sprintf(`{"node":{"id":%d},"relation_type":%q}`, rel.id, rel.relation)
+			if rel.kind == KindAnime {
+				anime = append(anime, node)
+			} else {
+				manga = append(manga, node)
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"id":%d,"title":"%s %d","media_type":"tv","start_date":"20%02d-01-01",
+			"related_anime":[%s],"related_manga":[%s]}`,
+			id, kind, id, id%100, strings.Join(anime, ","), strings.Join(manga, ","))
+	}
+}
+
+func TestAnime_Relations(t *testing.T) {
+	// 1 -> 2 -> 3 are sequels, 4 is side story of 2, 5 is manga adaptation of 1
+	relations := map[string][]fakeRelation{
+		"anime/1": {{KindAnime, 2, RelationSequel}, {KindManga, 5, RelationAdaptation}},
+		"anime/2": {{KindAnime, 1, RelationPrequel}, {KindAnime, 3, RelationSequel}, {KindAnime, 4, RelationSideStory}},
+		"anime/3": {{KindAnime, 2, RelationPrequel}, {KindAnime, 6, RelationSequel}},
+		"anime/4": {{KindAnime, 2, RelationParentStory}},
+		"manga/5": {{KindAnime, 1, RelationAdaptation}},
+		// anime/6 doesn't exist
+	}
+	requests := make(map[string]int)
+	mal := newFakeMAL(t, fakeFranchise(t, relations, requests))
+
+	graph, err := mal.Anime.Relations(context.Background(), 1, CrawlSettings{})
+	if err != nil {
+		t.Fatalf("Relations() error = %v", err)
+	}
+	if len(graph.Nodes) != 4 {
+		t.Errorf("Relations() loaded %d nodes, want 4: %v", len(graph.Nodes), graph.Keys())
+	}
+	for key, count := range requests {
+		if count > 1 {
+			t.Errorf("%s requested %d times", key, count)
+		}
+	}
+	if requests["manga/5"] != 0 {
+		t.Error("manga requested without CrossMedia")
+	}
+	if n := graph.Node(NodeKey{KindAnime, 3}); n == nil || n.Depth != 2 || n.Title() != "anime 3" {
+		t.Errorf("Node(3) = %+v", n)
+	}
+	if err := graph.Errors[NodeKey{KindAnime, 6}]; !errors.Is(err, ErrNotFound) {
+		t.Errorf("error of missing anime = %v", err)
+	}
+	if out := graph.Out(NodeKey{KindAnime, 2}); len(out) != 3 {
+		t.Errorf("Out(2) = %+v", out)
+	}
+
+	limited, err := mal.Anime.Relations(context.Background(), 1, CrawlSettings{
+		MaxDepth:   1,
+		Relations:  []RelationType{RelationSequel, RelationPrequel, RelationAdaptation},
+		CrossMedia: true,
+	})
+	if err != nil {
+		t.Fatalf("Relations() error = %v", err)
+	}
+	if got := fmt.Sprint(limited.Keys()); got != "[anime/1 anime/2 manga/5]" {
+		t.Errorf("Relations() with limits loaded %s", got)
+	}
+	// 2 -> 1 prequel edge exists, 2 -> 4 side story doesn't
+	if len(limited.Edges) != 4 {
+		t.Errorf("Relations() with limits edges = %+v", limited.Edges)
+	}
+}
+
+func TestAnime_Relations_Fields(t *testing.T) {
+	relations := map[string][]fakeRelation{
+		"anime/1": {{KindManga, 2, RelationAdaptation}},
+		"manga/2": {{KindAnime, 1, RelationAdaptation}},
+	}
+	var mu sync.Mutex
+	fields := make(map[string]string)
+	franchise := fakeFranchise(t, relations, make(map[string]int))
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fields[path.Base(path.Dir(r.URL.Path))] = r.URL.Query().Get("fields")
+		mu.Unlock()
+		franchise(w, r)
+	}))
+
+	graph, err := mal.Anime.Relations(context.Background(), 1, CrawlSettings{
+		CrossMedia: true,
+		Fields:     []Field{FieldMean, FieldNumEpisodes, FieldNumVolumes},
+	})
+	if err != nil {
+		t.Fatalf("Relations() error = %v", err)
+	}
+	if len(graph.Nodes) != 2 {
+		t.Errorf("Relations() loaded %d nodes, want 2: %v", len(graph.Nodes), graph.Keys())
+	}
+	anime, manga := fields[KindAnime], fields[KindManga]
+	if !strings.Contains(anime, "num_episodes") || strings.Contains(anime, "num_volumes") || !strings.Contains(anime, "mean") {
+		t.Errorf("anime requested with fields %q", anime)
+	}
+	if !strings.Contains(manga, "num_volumes") || strings.Contains(manga, "num_episodes") || !strings.Contains(manga, "mean") {
+		t.Errorf("manga requested with fields %q", manga)
+	}
+
+	if _, err := mal.Anime.Relations(context.Background(), 1, CrawlSettings{Fields: []Field{"unknown"}}); err == nil {
+		t.Error("Relations() accepted unknown field")
+	}
+}
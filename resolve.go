@@ -0,0 +1,287 @@
+package myanimelist
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/camelva/myanimelist-go/internal/fuzzy"
+)
+
+// ResolveSettings controls title resolution.
+type ResolveSettings struct {
+	// Limit is amount of search results per query variant. Default is 10
+	Limit int
+	// RunnerUps is maximal amount of returned runner-ups. Default is 4
+	RunnerUps int
+	// NSFW includes NSFW entries into search, see PagingSettings
+	NSFW bool
+}
+
+// Match is search result, scored against free text query.
+type Match[T any] struct {
+	Item T
+	// Title is the title of Item, which matched query best. It may be alternative title
+	Title string
+	// Confidence is similarity of Title and query, from 0 to 1
+	Confidence float64
+}
+
+// Resolution is result of title resolution.
+type Resolution[T any] struct {
+	// Best is the best match, or nil if nothing was found
+	Best *Match[T]
+	// RunnerUps are next matches, the most similar first
+	RunnerUps []Match[T]
+}
+
+// resolveFields are fields, required to score search results.
+var resolveFields = []Field{FieldAlternativeTitles, FieldMediaType}
+
+// Resolve finds anime by free text, such as "Shingeki no Kyojin S3 part 2" or "AoT".
+// Search is performed for several variants of query, then results are scored against
+// their titles and alternative titles (English, Japanese and synonyms).
+// Comparison ignores case and punctuation and treats "S3", "Season 3" and "3rd Season" as same.
+// Error is returned only if every search failed.
+func (a *Anime) Resolve(query string, settings ResolveSettings) (*Resolution[*AnimeDetails], error) {
+	return resolve(query, settings, func(q string, p PagingSettings) ([]*AnimeDetails, error) {
+		result, err := a.Search(q, p, resolveFields...)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]*AnimeDetails, len(result.Data))
+		for i := range result.Data {
+			items[i] = &result.Data[i].AnimeDetails
+		}
+		return items, nil
+	}, func(d *AnimeDetails) (int, []string) {
+		return d.ID, d.AlternativeTitles.all(d.Title)
+	})
+}
+
+// Resolve finds manga by free text. See Anime.Resolve for details.
+func (m *Manga) Resolve(query string, settings ResolveSettings) (*Resolution[*MangaDetails], error) {
+	return resolve(query, settings, func(q string, p PagingSettings) ([]*MangaDetails, error) {
+		result, err := m.Search(q, p, resolveFields...)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]*MangaDetails, len(result.Data))
+		for i := range result.Data {
+			items[i] = &result.Data[i].MangaDetails
+		}
+		return items, nil
+	}, func(d *MangaDetails) (int, []string) {
+		return d.ID, d.AlternativeTitles.all(d.Title)
+	})
+}
+
+// all returns main title and all non-empty alternative titles.
+func (t AlternativeTitles) all(title string) []string {
+	titles := []string{title}
+	for _, s := range append([]string{t.En, t.Ja}, t.Synonyms...) {
+		if s != "" {
+			titles = append(titles, s)
+		}
+	}
+	return titles
+}
+
+func resolve[T any](query string, settings ResolveSettings,
+	search func(q string, p PagingSettings) ([]T, error), titles func(T) (int, []string)) (*Resolution[T], error) {
+	if settings.Limit <= 0 {
+		settings.Limit = 10
+	}
+	if settings.RunnerUps <= 0 {
+		settings.RunnerUps = 4
+	}
+	queryTokens := titleTokens(query)
+
+	var matches []Match[T]
+	seen := make(map[int]bool)
+	var firstErr error
+	failed := 0
+	variants := queryVariants(query)
+	for _, q := range variants {
+		items, err := search(q, PagingSettings{Limit: settings.Limit, NSFW: settings.NSFW})
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed++
+			continue
+		}
+		for _, item := range items {
+			id, names := titles(item)
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			m := Match[T]{Item: item}
+			for _, name := range names {
+				if c := titleSimilarity(queryTokens, titleTokens(name)); c > m.Confidence {
+					m.Title, m.Confidence = name, c
+				}
+			}
+			matches = append(matches, m)
+		}
+	}
+	if failed == len(variants) && firstErr != nil {
+		return nil, firstErr
+	}
+
+	// stable sort keeps search order of equally similar matches
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Confidence > matches[j].Confidence
+	})
+	result := &Resolution[T]{}
+	if len(matches) > 0 {
+		result.Best = &matches[0]
+		matches = matches[1:]
+		if len(matches) > settings.RunnerUps {
+			matches = matches[:settings.RunnerUps]
+		}
+		result.RunnerUps = matches
+	}
+	return result, nil
+}
+
+// queryVariants returns original query and query without sequel markers, such as "S3" or "part 2".
+// Variants, which are too short for search, are skipped.
+func queryVariants(query string) []string {
+	query = strings.TrimSpace(query)
+	var base []string
+	for _, t := range titleTokens(query) {
+		if !isSequelMarker(t) {
+			base = append(base, t)
+		}
+	}
+
+	var variants []string
+	seen := make(map[string]bool)
+	for _, v := range []string{query, strings.Join(base, " ")} {
+		key := strings.ToLower(v)
+		if utf8.RuneCountInString(v) < 3 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		variants = append(variants, v)
+	}
+	return variants
+}
+
+var ordinalWords = map[string]int{"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5}
+
+var romanNumerals = map[string]int{"ii": 2, "iii": 3, "iv": 4, "vi": 6, "vii": 7, "viii": 8, "ix": 9}
+
+// titleTokens normalizes title into lowercase words without punctuation.
+// Sequel markers are unified: "season 3", "3rd season" and "s3" become "s3", "part 2" becomes "p2",
+// roman numerals become numbers.
+func titleTokens(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		next := ""
+		if i+1 < len(words) {
+			next = words[i+1]
+		}
+		if n, ok := romanNumerals[w]; ok {
+			w = strconv.Itoa(n)
+		}
+
+		switch {
+		case w == "season" && isNumber(next):
+			w = "s" + canonicalNumber(next)
+			i++
+		case (w == "part" || w == "pt" || w == "cour") && isNumber(next):
+			w = "p" + canonicalNumber(next)
+			i++
+		case next == "season" && ordinal(w) > 0:
+			w = "s" + strconv.Itoa(ordinal(w))
+			i++
+		case isSequelMarker(w) && !isNumber(w):
+			w = w[:1] + canonicalNumber(w[1:])
+		}
+		tokens = append(tokens, w)
+	}
+	return tokens
+}
+
+// ordinal parses "3rd" or "third", returning 0 for other words.
+func ordinal(w string) int {
+	if n, ok := ordinalWords[w]; ok {
+		return n
+	}
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		if strings.HasSuffix(w, suffix) {
+			if n, err := strconv.Atoi(strings.TrimSuffix(w, suffix)); err == nil {
+				return n
+			}
+		}
+	}
+	return 0
+}
+
+func isNumber(w string) bool {
+	_, err := strconv.Atoi(w)
+	return err == nil
+}
+
+// canonicalNumber removes leading zeros of number.
+func canonicalNumber(w string) string {
+	n, _ := strconv.Atoi(w)
+	return strconv.Itoa(n)
+}
+
+func isSequelMarker(t string) bool {
+	if len(t) > 1 && (t[0] == 's' || t[0] == 'p') && isNumber(t[1:]) {
+		return true
+	}
+	return isNumber(t)
+}
+
+// titleSimilarity compares normalized titles, returning value from 0 to 1.
+// It's the best of word overlap, edit distance and acronym match.
+func titleSimilarity(query, title []string) float64 {
+	if len(query) == 0 || len(title) == 0 {
+		return 0
+	}
+
+	// Dice coefficient of word sets
+	words := makeList(title)
+	common := 0
+	for w := range makeList(query) {
+		if _, ok := words[w]; ok {
+			common++
+		}
+	}
+	best := 2 * float64(common) / float64(len(makeList(query))+len(words))
+
+	a, b := []rune(strings.Join(query, " ")), []rune(strings.Join(title, " "))
+	longest := len(a)
+	if len(b) > longest {
+		longest = len(b)
+	}
+	if s := 1 - float64(fuzzy.Levenshtein(a, b))/float64(longest); s > best {
+		best = s
+	}
+
+	// "aot" is acronym of "attack on titan"
+	if len(query) == 1 && len(title) > 1 {
+		var acronym []rune
+		for _, w := range title {
+			r, _ := utf8.DecodeRuneInString(w)
+			acronym = append(acronym, r)
+		}
+		if string(acronym) == query[0] && best < 0.9 {
+			best = 0.9
+		}
+	}
+	return best
+}
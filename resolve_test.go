@@ -0,0 +1,79 @@
+package myanimelist
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestTitleTokens(t *testing.T) {
+	tests := map[string]string{
+		"Shingeki no Kyojin S3 part 2":            "[shingeki no kyojin s3 p2]",
+		"Shingeki no Kyojin Season 3 Part 2":      "[shingeki no kyojin s3 p2]",
+		"Shingeki no Kyojin: 3rd Season (Part.2)": "[shingeki no kyojin s3 p2]",
+		"Overlord II": "[overlord 2]",
+		"Kaguya-sama wa Kokurasetai? Second Season": "[kaguya sama wa kokurasetai s2]",
+		"Re:Zero S02":     "[re zero s2]",
+		"Hunter x Hunter": "[hunter x hunter]",
+	}
+	for title, want := range tests {
+		if got := fmt.Sprint(titleTokens(title)); got != want {
+			t.Errorf("titleTokens(%q) = %s, want %s", title, got, want)
+		}
+	}
+}
+
+func TestAnime_Resolve(t *testing.T) {
+	results := map[string]string{
+		"Shingeki no Kyojin S3 part 2": `{"node":{"id":16498,"title":"Shingeki no Kyojin","alternative_titles":{"en":"Attack on Titan"}}},
+			{"node":{"id":38524,"title":"Shingeki no Kyojin Season 3 Part 2"}}`,
+		"shingeki no kyojin": `{"node":{"id":16498,"title":"Shingeki no Kyojin"}},
+			{"node":{"id":35760,"title":"Shingeki no Kyojin Season 3","alternative_titles":{"synonyms":["SnK S3"]}}}`,
+		"AoT": `{"node":{"id":1,"title":"Aoi Tori"}},
+			{"node":{"id":16498,"title":"Shingeki no Kyojin","alternative_titles":{"en":"Attack on Titan"}}}`,
+	}
+	var mu sync.Mutex
+	var queries []string
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query().Get("q")
+		mu.Lock()
+		queries = append(queries, q)
+		mu.Unlock()
+		if !strings.Contains(r.URL.Query().Get("fields"), "alternative_titles") {
+			t.Errorf("search without alternative titles: %s", r.URL)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"paging":{}}`, results[q])
+	}))
+
+	res, err := mal.Anime.Resolve("Shingeki no Kyojin S3 part 2", ResolveSettings{})
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if fmt.Sprint(queries) != "[Shingeki no Kyojin S3 part 2 shingeki no kyojin]" {
+		t.Errorf("Resolve() searched %q", queries)
+	}
+	if res.Best == nil || res.Best.Item.ID != 38524 || res.Best.Confidence != 1 {
+		t.Fatalf("Resolve() best = %+v", res.Best)
+	}
+	if len(res.RunnerUps) != 2 || res.RunnerUps[0].Item.ID != 35760 || res.RunnerUps[0].Confidence < res.RunnerUps[1].Confidence {
+		t.Errorf("Resolve() runner-ups = %+v", res.RunnerUps)
+	}
+
+	res, err = mal.Anime.Resolve("AoT", ResolveSettings{RunnerUps: 1})
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if res.Best == nil || res.Best.Item.ID != 16498 || res.Best.Title != "Attack on Titan" || res.Best.Confidence < 0.9 {
+		t.Errorf("Resolve(AoT) best = %+v", res.Best)
+	}
+	if len(res.RunnerUps) != 1 || res.RunnerUps[0].Confidence >= res.Best.Confidence {
+		t.Errorf("Resolve(AoT) runner-ups = %+v", res.RunnerUps)
+	}
+
+	res, err = mal.Anime.Resolve("unknown title", ResolveSettings{})
+	if err != nil || res.Best != nil {
+		t.Errorf("Resolve() of unknown title = %+v, %v", res, err)
+	}
+}
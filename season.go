@@ -0,0 +1,145 @@
+package myanimelist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CurrentSeason returns season, which is going on in Japan at now.
+func CurrentSeason(now time.Time) Season {
+	return SeasonFromTime(now.In(JST))
+}
+
+// SeasonFromTime returns season of t's date, in t's location.
+func SeasonFromTime(t time.Time) Season {
+	return Season{Year: t.Year(), Season: seasons[(int(t.Month())-1)/3]}
+}
+
+// ParseSeason parses season, written as "spring 2021", "2021 spring" or "2021-spring". Case doesn't matter.
+func ParseSeason(s string) (Season, error) {
+	parts := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool { return r == ' ' || r == '-' || r == '/' })
+	if len(parts) != 2 {
+		return Season{}, fmt.Errorf("invalid season %q", s)
+	}
+	if _, err := strconv.Atoi(parts[0]); err == nil {
+		parts[0], parts[1] = parts[1], parts[0]
+	}
+
+	year, err := strconv.Atoi(parts[1])
+	name := SeasonName(parts[0])
+	if err != nil || year <= 0 || !name.Valid() {
+		return Season{}, fmt.Errorf("invalid season %q", s)
+	}
+	return Season{Year: year, Season: name}, nil
+}
+
+// String returns season as "spring 2021", which ParseSeason accepts.
+func (s Season) String() string {
+	return fmt.Sprintf("%s %d", s.Season, s.Year)
+}
+
+// index is number of season since year 0.
+func (s Season) index() int {
+	for i, name := range seasons {
+		if name == s.Season {
+			return s.Year*len(seasons) + i
+		}
+	}
+	return s.Year * len(seasons)
+}
+
+// seasonAt is reverse of index. Division is rounded down, so seasons before year 0 work too.
+func seasonAt(index int) Season {
+	n := len(seasons)
+	i := (index%n + n) % n
+	return Season{Year: (index - i) / n, Season: seasons[i]}
+}
+
+// Next returns following season.
+func (s Season) Next() Season {
+	return seasonAt(s.index() + 1)
+}
+
+// Prev returns preceding season.
+func (s Season) Prev() Season {
+	return seasonAt(s.index() - 1)
+}
+
+// Before reports whether s goes before other.
+func (s Season) Before(other Season) bool {
+	return s.index() < other.index()
+}
+
+// Start returns first moment of season in JST.
+func (s Season) Start() time.Time {
+	month := time.Month((s.index()-s.Year*len(seasons))*3 + 1)
+	return time.Date(s.Year, month, 1, 0, 0, 0, 0, JST)
+}
+
+// End returns first moment after season in JST.
+func (s Season) End() time.Time {
+	return s.Next().Start()
+}
+
+// SeasonRange returns all seasons from first to last, both inclusive.
+// If last is before first, range goes backwards.
+func SeasonRange(first, last Season) []Season {
+	step := 1
+	if last.Before(first) {
+		step = -1
+	}
+	var result []Season
+	for i := first.index(); ; i += step {
+		result = append(result, seasonAt(i))
+		if i == last.index() {
+			return result
+		}
+	}
+}
+
+// SeasonalOf is Seasonal, which accepts Season.
+func (a *Anime) SeasonalOf(season Season, sort string, settings PagingSettings, fields ...Field) (*AnimeSeasonal, error) {
+	return a.Seasonal(season.Year, season.Season, sort, settings, fields...)
+}
+
+// SeasonalEntry is anime of multiple seasons listing with seasons, in which it appeared.
+type SeasonalEntry struct {
+	AnimeEntry
+	Seasons []Season
+}
+
+// Seasons requests complete seasonal listings of all provided seasons and merges them.
+// Every anime appears once, in order of first appearance, with list of seasons, which listed it.
+// For sort and fields see Seasonal.
+func (a *Anime) Seasons(list []Season, sort string, fields ...Field) ([]SeasonalEntry, error) {
+	var merged []SeasonalEntry
+	positions := make(map[int]int)
+	requested := make(map[Season]bool, len(list))
+	for _, season := range list {
+		if requested[season] {
+			continue
+		}
+		requested[season] = true
+
+		listing, err := a.SeasonalOf(season, sort, PagingSettings{Limit: 100}, fields...)
+		if err != nil {
+			return nil, fmt.Errorf("season %s: %w", season, err)
+		}
+		entries, err := listing.FetchAll(FetchAllSettings{})
+		if err != nil {
+			return nil, fmt.Errorf("season %s: %w", season, err)
+		}
+
+		for _, e := range entries {
+			if i, ok := positions[e.ID]; ok {
+				merged[i].Seasons = append(merged[i].Seasons, season)
+				continue
+			}
+			positions[e.ID] = len(merged)
+			merged = append(merged, SeasonalEntry{AnimeEntry: e, Seasons: []Season{season}})
+		}
+	}
+	return merged, nil
+}
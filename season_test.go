@@ -0,0 +1,88 @@
+package myanimelist
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSeason(t *testing.T) {
+	// 2021-03-31 23:30 UTC is already April in Japan
+	now := time.Date(2021, 3, 31, 23, 30, 0, 0, time.UTC)
+	current := CurrentSeason(now)
+	if current != (Season{2021, SeasonSpring}) {
+		t.Errorf("CurrentSeason() = %v, want spring 2021", current)
+	}
+	if got := SeasonFromTime(now); got != (Season{2021, SeasonWinter}) {
+		t.Errorf("SeasonFromTime() = %v, want winter 2021", got)
+	}
+
+	winter := Season{2021, SeasonWinter}
+	if winter.Prev() != (Season{2020, SeasonFall}) || winter.Prev().Next() != winter {
+		t.Errorf("Prev() = %v, Prev().Next() = %v", winter.Prev(), winter.Prev().Next())
+	}
+	zero := Season{0, SeasonWinter}
+	if zero.Prev() != (Season{-1, SeasonFall}) || zero.Prev().Next() != zero {
+		t.Errorf("Prev() of year 0 = %v, Prev().Next() = %v", zero.Prev(), zero.Prev().Next())
+	}
+	if got := zero.Prev().Start(); !got.Equal(time.Date(-1, 10, 1, 0, 0, 0, 0, JST)) {
+		t.Errorf("Start() of year -1 = %v", got)
+	}
+	if !winter.Start().Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, JST)) ||
+		!winter.End().Equal(time.Date(2021, 4, 1, 0, 0, 0, 0, JST)) {
+		t.Errorf("winter = [%v, %v)", winter.Start(), winter.End())
+	}
+
+	for _, s := range []string{"spring 2021", "Spring 2021", "2021 spring", "2021-SPRING", "spring/2021"} {
+		if got, err := ParseSeason(s); err != nil || got != current {
+			t.Errorf("ParseSeason(%q) = %v, %v", s, got, err)
+		}
+	}
+	for _, s := range []string{"", "spring", "monsoon 2021", "spring 2021 fall"} {
+		if _, err := ParseSeason(s); err == nil {
+			t.Errorf("ParseSeason(%q) succeeded", s)
+		}
+	}
+	if current.String() != "spring 2021" {
+		t.Errorf("String() = %q", current.String())
+	}
+
+	forward := SeasonRange(Season{2020, SeasonFall}, Season{2021, SeasonSummer})
+	if fmt.Sprint(forward) != "[fall 2020 winter 2021 spring 2021 summer 2021]" {
+		t.Errorf("SeasonRange() = %v", forward)
+	}
+	backward := SeasonRange(Season{2021, SeasonWinter}, Season{2020, SeasonFall})
+	if fmt.Sprint(backward) != "[winter 2021 fall 2020]" {
+		t.Errorf("SeasonRange() backwards = %v", backward)
+	}
+}
+
+func TestAnime_Seasons(t *testing.T) {
+	// every season lists anime of itself and the previous one
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		season, err := ParseSeason(parts[len(parts)-2] + " " + parts[len(parts)-1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := season.index()
+		_, _ = fmt.Fprintf(w, `{"data":[{"node":{"id":%d}},{"node":{"id":%d}}],"paging":{}}`, id-1, id)
+	}))
+
+	spring := Season{2021, SeasonSpring}
+	merged, err := mal.Anime.Seasons([]Season{spring, spring.Next(), spring}, SortByScore)
+	if err != nil {
+		t.Fatalf("Seasons() error = %v", err)
+	}
+	if len(merged) != 3 {
+		t.Fatalf("Seasons() returned %d entries, want 3", len(merged))
+	}
+	if got := fmt.Sprint(merged[1].Seasons); merged[1].ID != spring.index() || got != "[spring 2021 summer 2021]" {
+		t.Errorf("Seasons() entry %d appeared in %s", merged[1].ID, got)
+	}
+	if len(merged[0].Seasons) != 1 || len(merged[2].Seasons) != 1 {
+		t.Errorf("Seasons() = %+v", merged)
+	}
+}
@@ -0,0 +1,108 @@
+package myanimelist
+
+import "strings"
+
+// SeasonalClass tells how anime of seasonal listing relates to listed season.
+type SeasonalClass int
+
+const (
+	// SeasonalUnknown is class of anime without start season
+	SeasonalUnknown SeasonalClass = iota
+	// SeasonalNew is anime, which starts in listed season
+	SeasonalNew
+	// SeasonalContinuing is anime, which started in the previous season, usually its second cour
+	SeasonalContinuing
+	// SeasonalLeftover is long-running anime, which started two or more seasons before
+	SeasonalLeftover
+)
+
+var seasonalClassNames = map[SeasonalClass]string{
+	SeasonalUnknown:    "unknown",
+	SeasonalNew:        "new",
+	SeasonalContinuing: "continuing",
+	SeasonalLeftover:   "leftover",
+}
+
+func (c SeasonalClass) String() string {
+	return seasonalClassNames[c]
+}
+
+// ClassifySeasonal tells how anime, which started at start, relates to season.
+// Anime, starting after season, is considered new.
+func ClassifySeasonal(start Season, season Season) SeasonalClass {
+	switch {
+	case start.Year == 0 || !start.Season.Valid():
+		return SeasonalUnknown
+	case !start.Before(season):
+		return SeasonalNew
+	case start == season.Prev():
+		return SeasonalContinuing
+	}
+	return SeasonalLeftover
+}
+
+// Class returns class of entry, relatively to listed season.
+func (obj *AnimeSeasonal) Class(entry AnimeEntry) SeasonalClass {
+	return ClassifySeasonal(entry.StartSeason, obj.listedSeason())
+}
+
+// listedSeason returns season of listing. If server didn't return it, it's taken from request.
+func (obj *AnimeSeasonal) listedSeason() Season {
+	if obj.Season.Year != 0 {
+		return obj.Season
+	}
+	// endpoint is "anime/season/{year}/{season}"
+	parts := strings.Split(obj.request.endpoint, "/")
+	if len(parts) < 2 {
+		return Season{}
+	}
+	season, _ := ParseSeason(parts[len(parts)-2] + " " + parts[len(parts)-1])
+	return season
+}
+
+// Only returns view of current page with entries of provided classes only.
+// Pages, requested through view, such as its Next(), Prev(), Offset() or FetchAll(), are filtered too.
+// Removed entries are added to Filtered().
+func (obj *AnimeSeasonal) Only(classes ...SeasonalClass) *AnimeSeasonal {
+	wanted := makeList(classes)
+	if obj.classes != nil {
+		// view of view keeps classes of both
+		for c := range wanted {
+			if _, ok := obj.classes[c]; !ok {
+				delete(wanted, c)
+			}
+		}
+	}
+	view := *obj
+	view.classes = wanted
+	view.Data = append([]AnimeEntry(nil), obj.Data...)
+	view.filterView()
+	return &view
+}
+
+func (obj *AnimeSeasonal) filterView() {
+	if obj.classes == nil {
+		return
+	}
+	var removed int
+	obj.Data, removed = filterEntries(obj.Data, func(e *AnimeEntry) bool {
+		_, ok := obj.classes[obj.Class(*e)]
+		return ok
+	})
+	obj.filtered += removed
+}
+
+// New returns view of current page with anime, which starts in listed season. See Only.
+func (obj *AnimeSeasonal) New() *AnimeSeasonal {
+	return obj.Only(SeasonalNew)
+}
+
+// Continuing returns view of current page with anime, continuing from previous season. See Only.
+func (obj *AnimeSeasonal) Continuing() *AnimeSeasonal {
+	return obj.Only(SeasonalContinuing)
+}
+
+// Leftover returns view of current page with long-running anime. See Only.
+func (obj *AnimeSeasonal) Leftover() *AnimeSeasonal {
+	return obj.Only(SeasonalLeftover)
+}
@@ -0,0 +1,86 @@
+package myanimelist
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAnimeSeasonal_Class(t *testing.T) {
+	// page 1 has new, continuing, leftover and unknown anime; page 2 has one more new anime
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Query().Get("fields"), "start_season") {
+			t.Errorf("Seasonal() didn't request start_season: %s", r.URL)
+		}
+		if r.URL.Query().Get("offset") == "4" {
+			_, _ = w.Write([]byte(`{"data":[{"node":{"id":5,"start_season":{"year":2021,"season":"spring"}}}],"paging":{}}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[
+			{"node":{"id":1,"start_season":{"year":2021,"season":"spring"}}},
+			{"node":{"id":2,"start_season":{"year":2021,"season":"winter"}}},
+			{"node":{"id":3,"start_season":{"year":1999,"season":"fall"}}},
+			{"node":{"id":4}}],
+			"paging":{"next":"http://%s/v2/anime/season/2021/spring?limit=4&offset=4"}}`, r.Host)
+	}))
+
+	result, err := mal.Anime.Seasonal(2021, SeasonSpring, "", PagingSettings{Limit: 4})
+	if err != nil {
+		t.Fatalf("Seasonal() error = %v", err)
+	}
+
+	want := []SeasonalClass{SeasonalNew, SeasonalContinuing, SeasonalLeftover, SeasonalUnknown}
+	for i, e := range result.Data {
+		if got := result.Class(e); got != want[i] {
+			t.Errorf("Class() of %d = %v, want %v", e.ID, got, want[i])
+		}
+	}
+
+	views := []struct {
+		view *AnimeSeasonal
+		id   int
+	}{
+		{result.New(), 1},
+		{result.Continuing(), 2},
+		{result.Leftover(), 3},
+	}
+	for _, v := range views {
+		if len(v.view.Data) != 1 || v.view.Data[0].ID != v.id || v.view.Filtered() != 3 {
+			t.Errorf("view = %+v, filtered %d, want only %d", v.view.Data, v.view.Filtered(), v.id)
+		}
+	}
+	if len(result.Data) != 4 {
+		t.Error("view changed original page")
+	}
+
+	next, err := result.Continuing().Next()
+	if err != nil {
+		t.Fatalf("Next() of view error = %v", err)
+	}
+	// page 2 has no continuing anime
+	if next.CurrentPage() != 2 || len(next.Data) != 0 || next.Filtered() != 1 {
+		t.Errorf("Next() of view = page %d with %+v, filtered %d", next.CurrentPage(), next.Data, next.Filtered())
+	}
+
+	all, err := result.New().FetchAll(FetchAllSettings{})
+	if err != nil {
+		t.Fatalf("FetchAll() of view error = %v", err)
+	}
+	if len(all) != 2 || all[0].ID != 1 || all[1].ID != 5 {
+		t.Errorf("FetchAll() of view = %+v, want 1 and 5", all)
+	}
+
+	var ids []int
+	for it := result.Leftover().Iter(); it.Next(); {
+		ids = append(ids, it.Item().ID)
+	}
+	if fmt.Sprint(ids) != "[3]" {
+		t.Errorf("Iter() of view = %v, want [3]", ids)
+	}
+
+	both := result.Only(SeasonalNew, SeasonalContinuing).Only(SeasonalNew, SeasonalLeftover)
+	if len(both.Data) != 1 || both.Data[0].ID != 1 {
+		t.Errorf("view of view = %+v, want only 1", both.Data)
+	}
+}
@@ -0,0 +1,100 @@
+package myanimelist
+
+import (
+	"context"
+	"sync"
+)
+
+// Stream delivers entries of paged result one by one through channel.
+// While you handle entries of current page, next page is already requested.
+// Channel is closed after last entry, error or context cancellation.
+// Usage:
+//
+//	stream := result.Stream(ctx)
+//	for item := range stream.Items() {
+//		// handle item
+//	}
+//	if err := stream.Err(); err != nil {
+//		// handle error
+//	}
+type Stream[T any] struct {
+	items chan T
+
+	mu  sync.Mutex
+	err error
+}
+
+func newStream[T any](ctx context.Context, first page[T]) *Stream[T] {
+	s := &Stream[T]{items: make(chan T)}
+	go s.run(ctx, first)
+	return s
+}
+
+// Items returns channel with entries. It's unbuffered, so next entries
+// are not pulled until you receive current one.
+func (s *Stream[T]) Items() <-chan T {
+	return s.items
+}
+
+// Err returns error, which stopped the stream, if any.
+// Call it after Items() channel is closed.
+func (s *Stream[T]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *Stream[T]) setErr(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+}
+
+type fetchedPage[T any] struct {
+	page page[T]
+	err  error
+}
+
+func (s *Stream[T]) run(ctx context.Context, current page[T]) {
+	defer close(s.items)
+
+	for {
+		// prefetch next page while consumer handles current one
+		var next chan fetchedPage[T]
+		if current.HasNext() {
+			next = make(chan fetchedPage[T], 1)
+			go func(p page[T]) {
+				result, err := nextPageContext(ctx, p)
+				next <- fetchedPage[T]{result, err}
+			}(current)
+		}
+
+		for _, item := range current.Items() {
+			select {
+			case s.items <- item:
+			case <-ctx.Done():
+				s.setErr(ctx.Err())
+				return
+			}
+		}
+
+		if next == nil {
+			return
+		}
+		fetched := <-next
+		if fetched.err != nil {
+			s.setErr(fetched.err)
+			return
+		}
+		current = fetched.page
+	}
+}
+
+// nextPageContext requests page, following provided one.
+func nextPageContext[T any](ctx context.Context, p page[T]) (page[T], error) {
+	req, err := p.linkRequest(p.paging().Next)
+	if err != nil {
+		return nil, err
+	}
+	return pageAt(ctx, p, req)
+}
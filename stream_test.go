@@ -0,0 +1,87 @@
+package myanimelist
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	mal := newFakeMAL(t, fakeListing(45))
+
+	result, err := mal.Manga.Top(RankAll, PagingSettings{Limit: 10})
+	if err != nil {
+		t.Fatalf("Top() error = %v", err)
+	}
+
+	stream := result.Stream(context.Background())
+	count := 0
+	for entry := range stream.Items() {
+		count++
+		if entry.ID != count {
+			t.Errorf("Stream() entry ID = %d, want %d", entry.ID, count)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		t.Errorf("Err() = %v", err)
+	}
+	if count != 45 {
+		t.Errorf("Stream() delivered %d entries, want 45", count)
+	}
+}
+
+func TestStream_Cancel(t *testing.T) {
+	mal := newFakeMAL(t, fakeListing(1000))
+
+	result, err := mal.Anime.Suggestions(PagingSettings{Limit: 10})
+	if err != nil {
+		t.Fatalf("Suggestions() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := result.Stream(ctx)
+	count := 0
+	for range stream.Items() {
+		count++
+		if count == 15 {
+			cancel()
+		}
+	}
+	if !errors.Is(stream.Err(), context.Canceled) {
+		t.Errorf("Err() = %v, want context.Canceled", stream.Err())
+	}
+	if count > 20 {
+		t.Errorf("Stream() delivered %d entries after cancellation", count)
+	}
+}
+
+func TestStream_Error(t *testing.T) {
+	listing := fakeListing(30)
+	mal := newFakeMAL(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "20" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not_found","message":""}`))
+			return
+		}
+		listing(w, r)
+	}))
+
+	result, err := mal.Forum.Search(ForumSearchSettings{Keyword: "test"}, PagingSettings{Limit: 10})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	stream := result.Stream(context.Background())
+	count := 0
+	for range stream.Items() {
+		count++
+	}
+	if count != 20 {
+		t.Errorf("Stream() delivered %d entries, want 20", count)
+	}
+	if stream.Err() == nil {
+		t.Error("Err() = nil, want error of third page")
+	}
+}
@@ -0,0 +1,126 @@
+package myanimelist
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrMalformedToken returned when access token can't be decoded as JWT.
+var ErrMalformedToken = errors.New("malformed access token")
+
+// TokenClaims contain data encoded inside user's access token.
+// MyAnimeList's access tokens are JWTs, so these values can be read
+// without any request to server.
+type TokenClaims struct {
+	// UserID is ID of user this token belongs to ("sub" claim)
+	UserID int
+	// IssuedAt is token's creation time ("iat" claim)
+	IssuedAt time.Time
+	// NotBefore is time token become valid ("nbf" claim)
+	NotBefore time.Time
+	// ExpireAt is token's expiration time ("exp" claim)
+	ExpireAt time.Time
+	// JTI is token's unique identifier ("jti" claim)
+	JTI string
+	// Audience usually equals to client ID of application, token was issued for ("aud" claim)
+	Audience []string
+	// Scopes granted to this token
+	Scopes []string
+}
+
+// Expired reports whether token already expired at provided moment.
+// Tokens without expiration time never expire.
+func (c *TokenClaims) Expired(now time.Time) bool {
+	if c.ExpireAt.IsZero() {
+		return false
+	}
+	return !now.Before(c.ExpireAt)
+}
+
+// ParseToken decodes claims of provided access token.
+// Keep in mind - token's signature is NOT verified,
+// so use it only with tokens received from trusted source.
+func ParseToken(accessToken string) (*TokenClaims, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: expected 3 parts, got %d", ErrMalformedToken, len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformedToken, err)
+	}
+
+	raw := new(tokenPayload)
+	if err := json.Unmarshal(payload, raw); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformedToken, err)
+	}
+
+	claims := &TokenClaims{
+		IssuedAt:  raw.IssuedAt.time(),
+		NotBefore: raw.NotBefore.time(),
+		ExpireAt:  raw.ExpireAt.time(),
+		JTI:       raw.JTI,
+		Audience:  raw.Audience,
+		Scopes:    raw.Scopes,
+	}
+	if raw.Subject != "" {
+		id, err := strconv.Atoi(string(raw.Subject))
+		if err != nil {
+			return nil, fmt.Errorf("%w: subject is not user id: %s", ErrMalformedToken, raw.Subject)
+		}
+		claims.UserID = id
+	}
+
+	return claims, nil
+}
+
+// TokenClaims decodes claims of current user's access token.
+func (a *Auth) TokenClaims() (*TokenClaims, error) {
+	return ParseToken(a.userToken)
+}
+
+type tokenPayload struct {
+	Audience  stringList  `json:"aud"`
+	JTI       string      `json:"jti"`
+	IssuedAt  numericDate `json:"iat"`
+	NotBefore numericDate `json:"nbf"`
+	ExpireAt  numericDate `json:"exp"`
+	Subject   stringOrInt `json:"sub"`
+	Scopes    []string    `json:"scopes"`
+}
+
+// numericDate is JWT's time representation: seconds since epoch,
+// possibly with fractional part.
+type numericDate float64
+
+func (d numericDate) time() time.Time {
+	if d == 0 {
+		return time.Time{}
+	}
+	sec, frac := math.Modf(float64(d))
+	return time.Unix(int64(sec), int64(frac*1e9))
+}
+
+// stringList accepts both single string and array of strings.
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*l = stringList{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
@@ -0,0 +1,161 @@
+package myanimelist
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func generateToken(payload string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"typ":"JWT","alg":"RS256"}`))
+	body := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return header + "." + body + ".signature"
+}
+
+func TestParseToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		want    *TokenClaims
+		wantErr bool
+	}{
+		{
+			name: "MyAnimeList token",
+			token: generateToken(`{"aud":"client","jti":"abc","iat":1600000000.5,"nbf":1600000000,` +
+				`"exp":1602678400,"sub":"12345","scopes":[]}`),
+			want: &TokenClaims{
+				UserID:    12345,
+				IssuedAt:  time.Unix(1600000000, 5e8),
+				NotBefore: time.Unix(1600000000, 0),
+				ExpireAt:  time.Unix(1602678400, 0),
+				JTI:       "abc",
+				Audience:  []string{"client"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Numeric subject",
+			token:   generateToken(`{"sub":42,"exp":1602678400}`),
+			want:    &TokenClaims{UserID: 42, ExpireAt: time.Unix(1602678400, 0)},
+			wantErr: false,
+		},
+		{
+			name:    "Opaque token",
+			token:   "very_long_string",
+			wantErr: true,
+		},
+		{
+			name:    "Broken payload",
+			token:   "a.!!!.c",
+			wantErr: true,
+		},
+		{
+			name:    "Non-numeric subject",
+			token:   generateToken(`{"sub":"someone"}`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, ErrMalformedToken) {
+					t.Errorf("ParseToken() error = %v, want ErrMalformedToken", err)
+				}
+				return
+			}
+			if got.UserID != tt.want.UserID || got.JTI != tt.want.JTI ||
+				!got.IssuedAt.Equal(tt.want.IssuedAt) || !got.NotBefore.Equal(tt.want.NotBefore) ||
+				!got.ExpireAt.Equal(tt.want.ExpireAt) || len(got.Audience) != len(tt.want.Audience) {
+				t.Errorf("ParseToken() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuth_SetTokenInfo(t *testing.T) {
+	mal, err := New(Config{ClientID: "mock", ClientSecret: "mock", RedirectURL: "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := generateToken(`{"sub":"1","exp":4102444800}`)
+
+	// server-issued tokens are saved, even if they aren't JWT
+	expire := time.Now().Add(time.Hour)
+	mal.Auth.SetTokenInfo("opaque", "refresh", expire)
+	if got := mal.Auth.GetTokenInfo(); got.AccessToken != "opaque" || !got.ExpireAt.Equal(expire) {
+		t.Errorf("SetTokenInfo() saved %+v, want opaque token", got)
+	}
+
+	mal.Auth.SetTokenInfo(token, "refresh", time.Time{})
+	if got := mal.Auth.GetTokenInfo().ExpireAt; !got.Equal(time.Unix(4102444800, 0)) {
+		t.Errorf("SetTokenInfo() expire = %v, want value from token", got)
+	}
+}
+
+func TestAuth_RestoreTokenInfo(t *testing.T) {
+	mal, err := New(Config{ClientID: "mock", ClientSecret: "mock", RedirectURL: "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := generateToken(`{"sub":"1","exp":4102444800}`)
+
+	if err := mal.Auth.RestoreTokenInfo("opaque", "refresh", time.Time{}); err == nil {
+		t.Error("RestoreTokenInfo() accepted malformed token")
+	}
+	if mal.Auth.GetTokenInfo().AccessToken != "" {
+		t.Error("RestoreTokenInfo() saved malformed token")
+	}
+
+	if err := mal.Auth.RestoreTokenInfo(token, "refresh", time.Time{}); err != nil {
+		t.Fatalf("RestoreTokenInfo() error = %v", err)
+	}
+	if got := mal.Auth.GetTokenInfo().ExpireAt; !got.Equal(time.Unix(4102444800, 0)) {
+		t.Errorf("RestoreTokenInfo() expire = %v, want value from token", got)
+	}
+
+	explicit := time.Now().Add(time.Hour)
+	if err := mal.Auth.RestoreTokenInfo(token, "refresh", explicit); err != nil {
+		t.Fatalf("RestoreTokenInfo() error = %v", err)
+	}
+	if got := mal.Auth.GetTokenInfo().ExpireAt; !got.Equal(explicit) {
+		t.Errorf("RestoreTokenInfo() expire = %v, want %v", got, explicit)
+	}
+}
+
+func TestAuth_RefreshToken_NotJWT(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(tokenResponse{
+			TokenType:    "Bearer",
+			ExpiresIn:    3600,
+			AccessToken:  "opaque",
+			RefreshToken: "new_refresh",
+		})
+	}))
+	defer server.Close()
+
+	oldEndpoint := tokenEndpoint
+	tokenEndpoint = server.URL + "/v1/oauth2/token"
+	defer func() { tokenEndpoint = oldEndpoint }()
+
+	mal, err := New(Config{ClientID: "mock", ClientSecret: "mock", RedirectURL: "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// refresh token is already consumed by server, so new tokens must not be lost
+	user, err := mal.Auth.RefreshToken()
+	if err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+	if user.RefreshToken != "new_refresh" || mal.Auth.GetTokenInfo().RefreshToken != "new_refresh" {
+		t.Errorf("RefreshToken() didn't save new tokens")
+	}
+}
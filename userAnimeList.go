@@ -1,6 +1,7 @@
 package myanimelist
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -75,13 +76,12 @@ func (c AnimeConfig) SetID(id int) AnimeConfig {
 
 // SetStatus accept only StatusWatching, StatusCompleted, StatusOnHold,
 // StatusDropped or StatusPlanToWatch constants
-func (c AnimeConfig) SetStatus(status string) AnimeConfig {
-	acceptableStatuses := makeList(append(generalStatuses, animeStatuses...))
-	if _, ok := acceptableStatuses[status]; !ok {
+func (c AnimeConfig) SetStatus(status ListStatus) AnimeConfig {
+	if !status.IsAnime() {
 		// non-acceptable status, do nothing
 		return c
 	}
-	c["status"] = status
+	c["status"] = string(status)
 	return c
 }
 
@@ -138,38 +138,40 @@ func (c AnimeConfig) SetComment(text string) AnimeConfig {
 	return c
 }
 
-// AnimeStatus contains server response about certain anime
-type AnimeStatus struct {
-	Status             string    `json:"status"`
-	Score              int       `json:"score"`
-	NumWatchedEpisodes int       `json:"num_episodes_watched"`
-	IsRewatching       bool      `json:"is_rewatching"`
-	UpdatedAt          time.Time `json:"updated_at"`
-	Priority           int       `json:"priority"`
-	NumTimesRewatched  int       `json:"num_times_rewatched"`
-	RewatchValue       int       `json:"rewatch_value"`
-	Tags               []string  `json:"tags"`
-	Comments           string    `json:"comments"`
+// SetStartDate sets date, when user started. Empty date clears it.
+func (c AnimeConfig) SetStartDate(date PartialDate) AnimeConfig {
+	c["start_date"] = date.String()
+	return c
+}
+
+// SetFinishDate sets date, when user finished. Empty date clears it.
+func (c AnimeConfig) SetFinishDate(date PartialDate) AnimeConfig {
+	c["finish_date"] = date.String()
+	return c
 }
 
+// AnimeStatus contains server response about certain anime
+type AnimeStatus = AnimeListStatus
+
 // UserAnimeList returns anime list of certain user with provided username (for current user use empty string).
 // You can set status to retrieve only anime's with same status or use empty object.
 // You can sort list by using on of these constants: SortListByScore, SortListByUpdateDate,
 // SortListByTitle, SortListByStartDate, SortListByID or provide empty object to disable sorting
-func (al *AnimeList) User(username string, status string, sort string, settings PagingSettings) (*UserAnimeList, error) {
+// If fields are provided, FieldListStatus is added to them, so ListStatus of entries is always filled.
+func (al *AnimeList) User(username string, status ListStatus, sort string, settings PagingSettings, fields ...Field) (*UserAnimeList, error) {
+	return al.user(context.Background(), username, status, sort, settings, fields...)
+}
+
+func (al *AnimeList) user(ctx context.Context, username string, status ListStatus, sort string, settings PagingSettings, fields ...Field) (*UserAnimeList, error) {
 	if username == "" {
 		username = "@me"
 	}
 
-	method := http.MethodGet
 	path := fmt.Sprintf("./users/%s/animelist", username)
 
 	data := url.Values{}
-	if status != "" {
-		acceptable := makeList(append(generalStatuses, animeStatuses...))
-		if _, ok := acceptable[sort]; ok {
-			data.Add("status", status)
-		}
+	if status.IsAnime() {
+		data.Add("status", string(status))
 	}
 	if sort != "" {
 		acceptable := makeList(listSortings)
@@ -178,10 +180,17 @@ func (al *AnimeList) User(username string, status string, sort string, settings
 		}
 	}
 
+	// with explicit fields server omits list_status, unless it's requested too
+	if len(fields) > 0 {
+		fields = append(append([]Field(nil), fields...), FieldListStatus)
+	}
+	if err := setFields(data, fields, animeListSchema, "anime list"); err != nil {
+		return nil, err
+	}
 	settings.set(&data)
 
 	var userList = &UserAnimeList{parent: al}
-	if err := al.anime.mal.request(userList, method, path, data); err != nil {
+	if err := al.anime.mal.requestPage(ctx, userList, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 
@@ -189,34 +198,110 @@ func (al *AnimeList) User(username string, status string, sort string, settings
 }
 
 type UserAnimeList struct {
+	listing
 	parent *AnimeList
-	Data   []struct {
-		Node       `json:"node"`
-		ListStatus AnimeListStatus `json:"list_status"`
-	} `json:"data"`
-	Paging Paging `json:"paging"`
+	Data   []UserAnimeListEntry `json:"data"`
+	Paging Paging               `json:"paging"`
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *UserAnimeList) Prev(limit ...int) (result *UserAnimeList, err error) {
-	result = &UserAnimeList{parent: obj.parent}
-	err = obj.parent.anime.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *UserAnimeList) Prev(limit ...int) (*UserAnimeList, error) {
+	return adjacentPage[*UserAnimeList, UserAnimeListEntry](obj, -1, limit)
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *UserAnimeList) Next(limit ...int) (result *UserAnimeList, err error) {
-	result = &UserAnimeList{parent: obj.parent}
-	err = obj.parent.anime.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *UserAnimeList) Next(limit ...int) (*UserAnimeList, error) {
+	return adjacentPage[*UserAnimeList, UserAnimeListEntry](obj, 1, limit)
+}
+
+// HasNext reports whether there is next result page.
+func (obj *UserAnimeList) HasNext() bool {
+	return obj.Paging.Next != ""
+}
+
+// HasPrev reports whether there is previous result page.
+func (obj *UserAnimeList) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
+
+// Items returns entries of current page.
+func (obj *UserAnimeList) Items() []UserAnimeListEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *UserAnimeList) Iter() *Iterator[UserAnimeListEntry] {
+	return newIterator[UserAnimeListEntry](obj)
+}
+
+func (obj *UserAnimeList) emptyPage() page[UserAnimeListEntry] {
+	return &UserAnimeList{parent: obj.parent}
+}
+
+func (obj *UserAnimeList) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *UserAnimeList) client() *MAL {
+	return obj.parent.anime.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *UserAnimeList) FetchAll(settings FetchAllSettings) ([]UserAnimeListEntry, error) {
+	return fetchAll[UserAnimeListEntry](context.Background(), obj, settings, func(entry UserAnimeListEntry) int { return entry.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *UserAnimeList) Stream(ctx context.Context) *Stream[UserAnimeListEntry] {
+	return newStream[UserAnimeListEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *UserAnimeList) Offset(k int) (*UserAnimeList, error) {
+	return offsetPage[*UserAnimeList, UserAnimeListEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *UserAnimeList) First() (*UserAnimeList, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *UserAnimeList) Page(n int) (*UserAnimeList, error) {
+	return numberedPage[*UserAnimeList, UserAnimeListEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *UserAnimeList) CurrentPage() int {
+	return currentPage[UserAnimeListEntry](obj)
+}
+
+// UserAnimeListEntry is single anime of user's list with its list status.
+// By default only ID, Title and MainPicture of anime are filled,
+// request additional fields with field selection of AnimeList.User().
+type UserAnimeListEntry struct {
+	AnimeDetails `json:"node"`
+	ListStatus   AnimeListStatus `json:"list_status"`
 }
 
+// AnimeListStatus is state of anime in user's list.
 type AnimeListStatus struct {
-	Status             string    `json:"status"`
-	Score              int       `json:"score"`
-	NumWatchedEpisodes int       `json:"num_watched_episodes"`
-	IsRewatching       bool      `json:"is_rewatching"`
-	UpdatedAt          time.Time `json:"updated_at"`
+	Status             ListStatus  `json:"status"`
+	Score              int         `json:"score"`
+	NumWatchedEpisodes int         `json:"num_episodes_watched"`
+	IsRewatching       bool        `json:"is_rewatching"`
+	StartDate          PartialDate `json:"start_date"`
+	FinishDate         PartialDate `json:"finish_date"`
+	// Priority is 0 (low), 1 (medium) or 2 (high)
+	Priority          int       `json:"priority"`
+	NumTimesRewatched int       `json:"num_times_rewatched"`
+	RewatchValue      int       `json:"rewatch_value"`
+	Tags              []string  `json:"tags"`
+	Comments          string    `json:"comments"`
+	UpdatedAt         time.Time `json:"updated_at"`
 }
@@ -1,6 +1,7 @@
 package myanimelist
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -51,13 +52,12 @@ func (c MangaConfig) SetID(id int) MangaConfig {
 
 // SetStatus accept only StatusReading, StatusCompleted, StatusOnHold,
 // StatusDropped or StatusPlanToRead constants
-func (c MangaConfig) SetStatus(status string) MangaConfig {
-	acceptable := makeList(append(generalStatuses, mangaStatuses...))
-	if _, ok := acceptable[status]; !ok {
+func (c MangaConfig) SetStatus(status ListStatus) MangaConfig {
+	if !status.IsManga() {
 		// non-acceptable status, do nothing
 		return c
 	}
-	c["status"] = status
+	c["status"] = string(status)
 	return c
 }
 func (c MangaConfig) SetIsRereading(b bool) MangaConfig {
@@ -111,6 +111,18 @@ func (c MangaConfig) SetComment(text string) MangaConfig {
 	return c
 }
 
+// SetStartDate sets date, when user started. Empty date clears it.
+func (c MangaConfig) SetStartDate(date PartialDate) MangaConfig {
+	c["start_date"] = date.String()
+	return c
+}
+
+// SetFinishDate sets date, when user finished. Empty date clears it.
+func (c MangaConfig) SetFinishDate(date PartialDate) MangaConfig {
+	c["finish_date"] = date.String()
+	return c
+}
+
 // UpdateMangaStatus changes specified manga' properties according to provided MangaConfig.
 // Returns updated MangaStatus or error, if any.
 func (ml *MangaList) Update(config MangaConfig) (*MangaStatus, error) {
@@ -137,37 +149,22 @@ func (ml *MangaList) Update(config MangaConfig) (*MangaStatus, error) {
 }
 
 // MangaStatus contains server response about certain manga
-type MangaStatus struct {
-	Status          string    `json:"status"`
-	IsRereading     bool      `json:"is_rereading"`
-	NumVolumesRead  int       `json:"num_volumes_read"`
-	NumChaptersRead int       `json:"num_chapters_read"`
-	Score           int       `json:"score"`
-	UpdatedAt       time.Time `json:"updated_at"`
-	Priority        int       `json:"priority"`
-	NumTimesReread  int       `json:"num_times_reread"`
-	RereadValue     int       `json:"reread_value"`
-	Tags            []string  `json:"tags"`
-	Comments        string    `json:"comments"`
-}
+type MangaStatus = MangaListStatus
 
 // UserMangaList returns manga list of certain user with provided username
 // You can set status to retrieve only manga's with same status or use empty object
 // You can sort list by using on of these constants: SortListByScore, SortListByUpdateDate,
 // SortListByTitle, SortListByStartDate, SortListByID or provide empty object to disable sorting
-func (ml *MangaList) User(username string, status string, sort string, settings PagingSettings) (*UserMangaList, error) {
+// If fields are provided, FieldListStatus is added to them, so ListStatus of entries is always filled.
+func (ml *MangaList) User(username string, status ListStatus, sort string, settings PagingSettings, fields ...Field) (*UserMangaList, error) {
 	if username == "" {
 		username = "@me"
 	}
 
-	method := http.MethodGet
 	path := fmt.Sprintf("./users/%s/mangalist", username)
 	data := url.Values{}
-	if status != "" {
-		acceptable := makeList(append(generalStatuses, mangaStatuses...))
-		if _, ok := acceptable[status]; ok {
-			data.Add("status", status)
-		}
+	if status.IsManga() {
+		data.Add("status", string(status))
 	}
 	if sort != "" {
 		acceptable := makeList(listSortings)
@@ -175,10 +172,17 @@ func (ml *MangaList) User(username string, status string, sort string, settings
 			data.Add("sort", fixSorting(sort, "manga"))
 		}
 	}
+	// with explicit fields server omits list_status, unless it's requested too
+	if len(fields) > 0 {
+		fields = append(append([]Field(nil), fields...), FieldListStatus)
+	}
+	if err := setFields(data, fields, mangaListSchema, "manga list"); err != nil {
+		return nil, err
+	}
 	settings.set(&data)
 
 	var userList = &UserMangaList{parent: ml}
-	if err := ml.manga.mal.request(userList, method, path, data); err != nil {
+	if err := ml.manga.mal.requestPage(context.Background(), userList, newPageRequest(path, data)); err != nil {
 		return nil, err
 	}
 
@@ -186,35 +190,111 @@ func (ml *MangaList) User(username string, status string, sort string, settings
 }
 
 type UserMangaList struct {
+	listing
 	parent *MangaList
-	Data   []struct {
-		Node       `json:"node"`
-		ListStatus MangaListStatus `json:"list_status"`
-	} `json:"data"`
-	Paging Paging `json:"paging"`
+	Data   []UserMangaListEntry `json:"data"`
+	Paging Paging               `json:"paging"`
 }
 
 // Prev return previous result page.
-// If its first page - returns error.
-func (obj *UserMangaList) Prev(limit ...int) (result *UserMangaList, err error) {
-	result = &UserMangaList{parent: obj.parent}
-	err = obj.parent.manga.mal.getPage(result, obj.Paging, -1, limit)
-	return
+// If its first page - returns ErrNoMorePages.
+func (obj *UserMangaList) Prev(limit ...int) (*UserMangaList, error) {
+	return adjacentPage[*UserMangaList, UserMangaListEntry](obj, -1, limit)
 }
 
 // Next return next result page.
-// If its last page - returns error.
-func (obj *UserMangaList) Next(limit ...int) (result *UserMangaList, err error) {
-	result = &UserMangaList{parent: obj.parent}
-	err = obj.parent.manga.mal.getPage(result, obj.Paging, 1, limit)
-	return
+// If its last page - returns ErrNoMorePages.
+func (obj *UserMangaList) Next(limit ...int) (*UserMangaList, error) {
+	return adjacentPage[*UserMangaList, UserMangaListEntry](obj, 1, limit)
+}
+
+// HasNext reports whether there is next result page.
+func (obj *UserMangaList) HasNext() bool {
+	return obj.Paging.Next != ""
+}
+
+// HasPrev reports whether there is previous result page.
+func (obj *UserMangaList) HasPrev() bool {
+	return obj.Paging.Previous != ""
+}
+
+// Items returns entries of current page.
+func (obj *UserMangaList) Items() []UserMangaListEntry {
+	return obj.Data
+}
+
+// Iter returns iterator over entries of current and all next pages.
+func (obj *UserMangaList) Iter() *Iterator[UserMangaListEntry] {
+	return newIterator[UserMangaListEntry](obj)
+}
+
+func (obj *UserMangaList) emptyPage() page[UserMangaListEntry] {
+	return &UserMangaList{parent: obj.parent}
+}
+
+func (obj *UserMangaList) paging() Paging {
+	return obj.Paging
+}
+
+func (obj *UserMangaList) client() *MAL {
+	return obj.parent.manga.mal
+}
+
+// FetchAll returns entries of current and all next pages, requesting them concurrently.
+// Entries are returned in original order, without duplicates.
+func (obj *UserMangaList) FetchAll(settings FetchAllSettings) ([]UserMangaListEntry, error) {
+	return fetchAll[UserMangaListEntry](context.Background(), obj, settings, func(entry UserMangaListEntry) int { return entry.ID })
+}
+
+// Stream returns Stream of entries of current and all next pages.
+// Stream stops when ctx is done.
+func (obj *UserMangaList) Stream(ctx context.Context) *Stream[UserMangaListEntry] {
+	return newStream[UserMangaListEntry](ctx, obj)
+}
+
+// Offset returns result page, starting at k-th entry (counting from 0).
+// If there are no entries at this offset - returns ErrNoMorePages.
+func (obj *UserMangaList) Offset(k int) (*UserMangaList, error) {
+	return offsetPage[*UserMangaList, UserMangaListEntry](obj, k)
+}
+
+// First returns first result page.
+func (obj *UserMangaList) First() (*UserMangaList, error) {
+	return obj.Offset(0)
+}
+
+// Page returns n-th result page (counting from 1), keeping current page size.
+func (obj *UserMangaList) Page(n int) (*UserMangaList, error) {
+	return numberedPage[*UserMangaList, UserMangaListEntry](obj, n)
+}
+
+// CurrentPage returns number of current page (counting from 1).
+func (obj *UserMangaList) CurrentPage() int {
+	return currentPage[UserMangaListEntry](obj)
+}
+
+// UserMangaListEntry is single manga of user's list with its list status.
+// By default only ID, Title and MainPicture of manga are filled,
+// request additional fields with field selection of MangaList.User().
+type UserMangaListEntry struct {
+	MangaDetails `json:"node"`
+	ListStatus   MangaListStatus `json:"list_status"`
 }
 
+// MangaListStatus is state of manga in user's list.
 type MangaListStatus struct {
-	Status          string    `json:"status"`
-	Score           int       `json:"score"`
-	NumVolumesRead  int       `json:"num_volumes_read"`
-	NumChaptersRead int       `json:"num_chapters_read"`
-	IsRereading     bool      `json:"is_rereading"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	Status          ListStatus  `json:"status"`
+	Score           int         `json:"score"`
+	NumVolumesRead  int         `json:"num_volumes_read"`
+	NumChaptersRead int         `json:"num_chapters_read"`
+	IsRereading     bool        `json:"is_rereading"`
+	StartDate       PartialDate `json:"start_date"`
+	FinishDate      PartialDate `json:"finish_date"`
+	// Priority is 0 (low), 1 (medium) or 2 (high)
+	Priority       int       `json:"priority"`
+	NumTimesReread int       `json:"num_times_reread"`
+	RereadValue    int       `json:"reread_value"`
+	Tags           []string  `json:"tags"`
+	Comments       string    `json:"comments"`
+	UpdatedAt      time.Time `json:"updated_at"`
 }
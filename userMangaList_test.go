@@ -32,7 +32,7 @@ func TestMAL_Manga_List_Remove(t *testing.T) {
 func TestMAL_Manga_List_User(t *testing.T) {
 	type args struct {
 		username string
-		status   string
+		status   ListStatus
 		sort     string
 		settings PagingSettings
 	}
@@ -85,7 +85,7 @@ func TestMAL_Manga_List_Update(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		wantStatus string
+		wantStatus ListStatus
 		wantErr    bool
 	}{
 		{
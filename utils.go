@@ -2,11 +2,13 @@ package myanimelist
 
 import (
 	"crypto/rand"
+	"encoding/json"
 	"log"
+	"strconv"
 )
 
-func makeList(objects []string) map[string]struct{} {
-	var list = make(map[string]struct{}, len(objects))
+func makeList[T comparable](objects []T) map[T]struct{} {
+	var list = make(map[T]struct{}, len(objects))
 	if len(objects) < 1 {
 		return list
 	}
@@ -77,4 +79,28 @@ func randomString(length int, chars []byte) string {
 			}
 		}
 	}
-}
\ No newline at end of file
+}
+
+// stringOrInt accepts both quoted and plain numbers.
+type stringOrInt string
+
+func (s *stringOrInt) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*s = stringOrInt(str)
+		return nil
+	}
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return err
+	}
+	*s = stringOrInt(num.String())
+	return nil
+}
+
+func (s stringOrInt) int() (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(string(s))
+}
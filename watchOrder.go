@@ -0,0 +1,161 @@
+package myanimelist
+
+import "context"
+
+// WatchOrderSettings controls which parts of franchise get into watch order.
+// By default only main story (prequels and sequels) is included.
+type WatchOrderSettings struct {
+	// SideStories includes side stories and their parent stories
+	SideStories bool
+	// Specials includes entries with MediaSpecial and MediaMusic media types
+	Specials bool
+	// Recaps includes summaries
+	Recaps bool
+	// MaxDepth and Concurrency are passed to Anime.Relations
+	MaxDepth    int
+	Concurrency int
+}
+
+// WatchOrderEntry is single anime of watch order.
+type WatchOrderEntry struct {
+	Anime *AnimeDetails
+	// Completed is true, if anime is completed in current user's list
+	Completed bool
+}
+
+// WatchOrder builds franchise of anime and returns recommended order to watch it in.
+// Order follows prequel and sequel relations, parallel entries are ordered by start date.
+// See PlanWatchOrder for details.
+func (a *Anime) WatchOrder(ctx context.Context, animeID int, settings WatchOrderSettings) ([]WatchOrderEntry, error) {
+	graph, err := a.Relations(ctx, animeID, CrawlSettings{
+		MaxDepth:    settings.MaxDepth,
+		Relations:   settings.relations(),
+		Concurrency: settings.Concurrency,
+		Fields:      []Field{FieldMyListStatus, FieldNumEpisodes},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return PlanWatchOrder(graph, settings), nil
+}
+
+func (s WatchOrderSettings) relations() []RelationType {
+	relations := []RelationType{RelationPrequel, RelationSequel}
+	if s.SideStories {
+		relations = append(relations, RelationSideStory, RelationParentStory)
+	}
+	if s.Recaps {
+		relations = append(relations, RelationSummary, RelationFullStory)
+	}
+	return relations
+}
+
+// PlanWatchOrder orders anime of relation graph, so every entry goes after its prequels,
+// parent stories and stories it summarizes. When several entries can go next,
+// the one which started earlier is taken first. Manga and entries, excluded by settings, are skipped.
+// Entries are marked completed according to MyListStatus, so request FieldMyListStatus to fill it.
+func PlanWatchOrder(graph *RelationGraph, settings WatchOrderSettings) []WatchOrderEntry {
+	allowed := makeList(settings.relations())
+
+	// after[k] lists nodes, which must be watched after k,
+	// pending[k] is amount of nodes, which must be watched before k
+	after := make(map[NodeKey][]NodeKey)
+	pending := make(map[NodeKey]int)
+
+	// graph may be crawled with more relations, so only anime, connected to root by allowed ones, are planned
+	queue := []NodeKey{graph.Root}
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		if n := graph.Nodes[k]; n == nil || n.Anime == nil {
+			continue
+		}
+		if _, ok := pending[k]; ok {
+			continue
+		}
+		pending[k] = 0
+		for _, e := range graph.Edges {
+			if _, ok := allowed[e.Type]; !ok {
+				continue
+			}
+			if e.From == k {
+				queue = append(queue, e.To)
+			} else if e.To == k {
+				queue = append(queue, e.From)
+			}
+		}
+	}
+	addOrder := func(first, second NodeKey) {
+		_, ok1 := pending[first]
+		_, ok2 := pending[second]
+		if !ok1 || !ok2 || first == second {
+			return
+		}
+		after[first] = append(after[first], second)
+		pending[second]++
+	}
+	for _, e := range graph.Edges {
+		if _, ok := allowed[e.Type]; !ok {
+			continue
+		}
+		switch e.Type {
+		// To goes after From
+		case RelationSequel, RelationSideStory, RelationSummary:
+			addOrder(e.From, e.To)
+		// From goes after To
+		case RelationPrequel, RelationParentStory, RelationFullStory:
+			addOrder(e.To, e.From)
+		}
+	}
+
+	earlier := func(a, b NodeKey) bool {
+		da, db := graph.Nodes[a].StartDate(), graph.Nodes[b].StartDate()
+		// unknown dates go last
+		if da.IsZero() != db.IsZero() {
+			return !da.IsZero()
+		}
+		if c := da.Compare(db); c != 0 {
+			return c < 0
+		}
+		return a.ID < b.ID
+	}
+
+	var order []NodeKey
+	for len(pending) > 0 {
+		// take the earliest node without pending predecessors;
+		// if there is none because of cycle in relations - the earliest of remaining
+		var next *NodeKey
+		for _, free := range []bool{true, false} {
+			for k, count := range pending {
+				k := k
+				if (count == 0 || !free) && (next == nil || earlier(k, *next)) {
+					next = &k
+				}
+			}
+			if next != nil {
+				break
+			}
+		}
+
+		order = append(order, *next)
+		delete(pending, *next)
+		for _, k := range after[*next] {
+			if _, ok := pending[k]; ok {
+				pending[k]--
+			}
+		}
+	}
+
+	var result []WatchOrderEntry
+	for _, k := range order {
+		anime := graph.Nodes[k].Anime
+		if !settings.Specials && (anime.MediaType == MediaSpecial || anime.MediaType == MediaMusic) {
+			continue
+		}
+		result = append(result, WatchOrderEntry{
+			Anime:     anime,
+			Completed: anime.MyListStatus.Status == StatusCompleted,
+		})
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package myanimelist
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestPlanWatchOrder(t *testing.T) {
+	graph := &RelationGraph{Root: NodeKey{KindAnime, 1}, Nodes: make(map[NodeKey]*GraphNode)}
+	add := func(id int, media MediaType, start PartialDate, status ListStatus) {
+		key := NodeKey{KindAnime, id}
+		anime := &AnimeDetails{ID: id, MediaType: media, StartDate: start}
+		anime.MyListStatus.Status = status
+		graph.Nodes[key] = &GraphNode{Key: key, Anime: anime}
+	}
+	relate := func(from, to int, relation RelationType) {
+		graph.Edges = append(graph.Edges, GraphEdge{NodeKey{KindAnime, from}, NodeKey{KindAnime, to}, relation})
+	}
+	// 6 is prequel of 1, made later; 5 and 2 are sequels of 1; 3 is special side story of 1; 4 is recap of 2
+	add(1, MediaTV, NewPartialDate(2010, time.April, 1), StatusCompleted)
+	add(2, MediaTV, NewPartialDate(2012, 0, 0), "")
+	add(3, MediaSpecial, NewPartialDate(2011, time.January, 0), "")
+	add(4, MediaMovie, NewPartialDate(2013, 0, 0), "")
+	add(5, MediaOVA, NewPartialDate(2011, time.June, 0), "")
+	add(6, MediaTV, NewPartialDate(2015, 0, 0), "")
+	graph.Nodes[NodeKey{KindManga, 7}] = &GraphNode{Key: NodeKey{KindManga, 7}, Manga: &MangaDetails{}}
+	relate(1, 6, RelationPrequel)
+	relate(1, 5, RelationSequel)
+	relate(1, 2, RelationSequel)
+	relate(2, 1, RelationPrequel)
+	relate(1, 3, RelationSideStory)
+	relate(2, 4, RelationSummary)
+	relate(1, 7, RelationAdaptation)
+
+	tests := []struct {
+		settings WatchOrderSettings
+		want     string
+	}{
+		{WatchOrderSettings{}, "[6 1 5 2]"},
+		{WatchOrderSettings{SideStories: true}, "[6 1 5 2]"},
+		{WatchOrderSettings{SideStories: true, Specials: true}, "[6 1 3 5 2]"},
+		{WatchOrderSettings{Recaps: true}, "[6 1 5 2 4]"},
+	}
+	for _, tt := range tests {
+		order := PlanWatchOrder(graph, tt.settings)
+		ids := make([]int, len(order))
+		for i, e := range order {
+			ids[i] = e.Anime.ID
+		}
+		if got := fmt.Sprint(ids); got != tt.want {
+			t.Errorf("PlanWatchOrder(%+v) = %s, want %s", tt.settings, got, tt.want)
+		}
+		if !order[1].Completed || order[0].Completed {
+			t.Errorf("PlanWatchOrder(%+v) completed marks are wrong", tt.settings)
+		}
+	}
+}
+
+func TestAnime_WatchOrder(t *testing.T) {
+	// 3 is prequel of 1, but starts later; 2 is side story of 1 and isn't crawled by default
+	relations := map[string][]fakeRelation{
+		"anime/1": {{KindAnime, 3, RelationPrequel}, {KindAnime, 2, RelationSideStory}},
+		"anime/2": {{KindAnime, 1, RelationParentStory}},
+		"anime/3": {{KindAnime, 1, RelationSequel}},
+	}
+	requests := make(map[string]int)
+	mal := newFakeMAL(t, fakeFranchise(t, relations, requests))
+
+	order, err := mal.Anime.WatchOrder(context.Background(), 1, WatchOrderSettings{})
+	if err != nil {
+		t.Fatalf("WatchOrder() error = %v", err)
+	}
+	if len(order) != 2 || order[0].Anime.ID != 3 || order[1].Anime.ID != 1 {
+		t.Errorf("WatchOrder() = %+v", order)
+	}
+	if requests["anime/2"] != 0 {
+		t.Error("WatchOrder() crawled side story")
+	}
+}